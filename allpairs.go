@@ -0,0 +1,192 @@
+package graph
+
+import (
+	"container/list"
+	"math"
+	"sort"
+)
+
+// DistanceTable holds distances between all pairs of vertices
+// and next hops to rebuild the lightest paths
+type DistanceTable struct {
+	vertices []UVertex
+	index    map[string]int
+	dist     [][]float64
+	next     [][]int
+}
+
+func newDistanceTable(graph map[string]UVertex) *DistanceTable {
+	var t = &DistanceTable{
+		vertices: make([]UVertex, 0, len(graph)),
+		index:    make(map[string]int, len(graph)),
+		dist:     make([][]float64, len(graph)),
+		next:     make([][]int, len(graph)),
+	}
+	for _, v := range graph {
+		t.vertices = append(t.vertices, v)
+	}
+	sort.Slice(t.vertices, func(i, j int) bool {
+		return t.vertices[i].Id() < t.vertices[j].Id()
+	})
+	for i, v := range t.vertices {
+		t.index[v.Id()] = i
+		t.dist[i] = make([]float64, len(graph))
+		t.next[i] = make([]int, len(graph))
+		for j := range t.dist[i] {
+			t.dist[i][j] = math.Inf(1)
+			t.next[i][j] = -1
+		}
+		t.dist[i][i] = 0
+		t.next[i][i] = i
+	}
+	return t
+}
+
+// Distance returns weight of the lightest path between vertices,
+// it is +Inf if there is no path or a vertex is missing
+func (t *DistanceTable) Distance(from, to UVertex) float64 {
+	var i, okFrom = t.index[from.Id()]
+	var j, okTo = t.index[to.Id()]
+	if !okFrom || !okTo {
+		return math.Inf(1)
+	}
+	return t.dist[i][j]
+}
+
+// Path rebuilds the lightest path between vertices
+func (t *DistanceTable) Path(from, to UVertex) (*Path, error) {
+	var i, okFrom = t.index[from.Id()]
+	var j, okTo = t.index[to.Id()]
+	if !okFrom || !okTo {
+		return nil, ErrMissingVertex
+	}
+	if t.next[i][j] == -1 {
+		return nil, ErrNoPath
+	}
+	var path = &Path{
+		weight:   t.dist[i][j],
+		vertices: list.New(),
+	}
+	path.vertices.PushBack(t.vertices[i])
+	for i != j {
+		i = t.next[i][j]
+		path.vertices.PushBack(t.vertices[i])
+	}
+	return path, nil
+}
+
+// AllPairs computes the lightest paths between all pairs of vertices
+// using Floyd-Warshall algorithm on dense graphs and Johnson's one
+// on sparse graphs
+func (g *UWGraph) AllPairs() (*DistanceTable, error) {
+	return allPairs(g.graph)
+}
+
+// AllPairs computes the lightest paths between all pairs of vertices
+// using Floyd-Warshall algorithm on dense graphs and Johnson's one
+// on sparse graphs, it fails with NegativeCycleError if there is
+// a cycle of negative weight
+func (g *DWGraph) AllPairs() (*DistanceTable, error) {
+	return allPairs(g.graph)
+}
+
+func allPairs(graph map[string]UVertex) (*DistanceTable, error) {
+	var edges int
+	for _, v := range graph {
+		edges += v.Edges().Len()
+	}
+	if 4*edges >= len(graph)*len(graph) {
+		return floydWarshall(graph)
+	}
+	return johnsonAllPairs(graph)
+}
+
+func floydWarshall(graph map[string]UVertex) (*DistanceTable, error) {
+	var t = newDistanceTable(graph)
+	for i, v := range t.vertices {
+		for e := v.Edges().Front(); e != nil; e = e.Next() {
+			var edge = e.Value.(Edge)
+			var j = t.index[edge.To().Id()]
+			if edge.Weight() < t.dist[i][j] {
+				t.dist[i][j] = edge.Weight()
+				t.next[i][j] = j
+			}
+		}
+	}
+	var n = len(t.vertices)
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(t.dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if d := t.dist[i][k] + t.dist[k][j]; d < t.dist[i][j] {
+					t.dist[i][j] = d
+					t.next[i][j] = t.next[i][k]
+				}
+			}
+		}
+		for i := 0; i < n; i++ {
+			if t.dist[i][i] < 0 {
+				// let Bellman-Ford describe the cycle
+				var _, err = spfa(graph, t.vertices)
+				return nil, err
+			}
+		}
+	}
+	return t, nil
+}
+
+// johnsonAllPairs reweights edges with potentials found by Bellman-Ford algorithm,
+// so that none of them is negative, and runs Dijkstra from every vertex
+func johnsonAllPairs(graph map[string]UVertex) (*DistanceTable, error) {
+	var t = newDistanceTable(graph)
+	var potentials, err = spfa(graph, t.vertices)
+	if err != nil {
+		return nil, err
+	}
+	var s = &search{
+		graph: graph,
+		weight: func(e Edge) float64 {
+			// reweighted edges are not negative up to rounding errors
+			return math.Max(0, e.Weight()+potentials[e.From().Id()].weight-potentials[e.To().Id()].weight)
+		},
+	}
+	for i, v := range t.vertices {
+		var table, _ = s.run(v, nil)
+		var hops = make(map[string]int, len(table))
+		for id, rec := range table {
+			var j = t.index[id]
+			t.dist[i][j] = rec.weight - potentials[v.Id()].weight + potentials[id].weight
+			t.next[i][j] = firstHop(table, hops, t.index, v, t.vertices[j])
+		}
+	}
+	return t, nil
+}
+
+// firstHop returns index of the vertex following from on the path to the
+// target stored in table, hops memoizes results for vertices on the path
+func firstHop(table map[string]*row, hops map[string]int, index map[string]int, from, to UVertex) int {
+	if to.Equal(from) {
+		return index[from.Id()]
+	}
+	var path []string
+	var hop int
+	for v := to; ; {
+		if h, ok := hops[v.Id()]; ok {
+			hop = h
+			break
+		}
+		path = append(path, v.Id())
+		var previous = table[v.Id()].previous
+		if previous.Equal(from) {
+			hop = index[v.Id()]
+			break
+		}
+		v = previous
+	}
+	for _, id := range path {
+		hops[id] = hop
+	}
+	return hop
+}
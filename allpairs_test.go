@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestUWGraph_AllPairs(t *testing.T) {
+	var g = NewUWGraph()
+	for _, id := range []string{"A", "B", "C", "D", "E", "F"} {
+		g.Add(newUV(id))
+	}
+	g.Connect(newUV("A"), newUV("B"), 5)
+	g.Connect(newUV("A"), newUV("D"), 2)
+	g.Connect(newUV("D"), newUV("E"), 1)
+	g.Connect(newUV("B"), newUV("E"), 3)
+	g.Connect(newUV("E"), newUV("C"), 4)
+	var table, err = g.AllPairs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := table.Distance(newUV("D"), newUV("B")); d != 4 {
+		t.Errorf("Distance(D, B) = %v, want 4", d)
+	}
+	var path, _ = table.Path(newUV("C"), newUV("A"))
+	if ids := pathIds(path); ids != "CEDA" || path.Weight() != 7 {
+		t.Errorf("Path(C, A) = %s:%v, want CEDA:7", ids, path.Weight())
+	}
+	if d := table.Distance(newUV("A"), newUV("F")); !math.IsInf(d, 1) {
+		t.Errorf("Distance(A, F) = %v, want +Inf", d)
+	}
+	if _, err = table.Path(newUV("A"), newUV("F")); err != ErrNoPath {
+		t.Errorf("Expected ErrNoPath, got: %v", err)
+	}
+	if _, err = table.Path(newUV("A"), newUV("Q")); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+func TestDWGraph_AllPairs(t *testing.T) {
+	var rnd = rand.New(rand.NewSource(1))
+	var g = NewDWGraph()
+	var vs = make([]UVertex, 30)
+	for i := range vs {
+		vs[i] = newUV(strconv.Itoa(i))
+		g.Add(vs[i])
+	}
+	// edges go forward only, so negative weights make no cycles
+	for i := 0; i < 120; i++ {
+		var a, b = rnd.Intn(len(vs)), rnd.Intn(len(vs))
+		if a > b {
+			a, b = b, a
+		}
+		if a != b {
+			g.Connect(vs[a], vs[b], float64(rnd.Intn(15)-4))
+		}
+	}
+	var fw, err = floydWarshall(g.graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jn *DistanceTable
+	if jn, err = johnsonAllPairs(g.graph); err != nil {
+		t.Fatal(err)
+	}
+	for _, from := range vs {
+		for _, to := range vs {
+			var want = math.Inf(1)
+			if path, err := g.BellmanFord(from, to); err == nil {
+				want = path.Weight()
+			}
+			for name, table := range map[string]*DistanceTable{"floyd-warshall": fw, "johnson": jn} {
+				if d := table.Distance(from, to); d != want {
+					t.Fatalf("%s: Distance(%s, %s) = %v, want %v", name, from.Id(), to.Id(), d, want)
+				}
+				if math.IsInf(want, 1) {
+					continue
+				}
+				var path, err = table.Path(from, to)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var weight float64
+				for e := path.Vertices().Front(); e.Next() != nil; e = e.Next() {
+					var best = math.Inf(1)
+					var next = e.Next().Value.(UVertex)
+					for el := e.Value.(UVertex).Edges().Front(); el != nil; el = el.Next() {
+						if edge := el.Value.(Edge); edge.To().Equal(next) && edge.Weight() < best {
+							best = edge.Weight()
+						}
+					}
+					weight += best
+				}
+				if weight != want {
+					t.Fatalf("%s: Path(%s, %s) weighs %v, want %v", name, from.Id(), to.Id(), weight, want)
+				}
+			}
+		}
+	}
+
+	g.Connect(vs[29], vs[0], -100)
+	for name, allPairs := range map[string]func(map[string]UVertex) (*DistanceTable, error){
+		"floyd-warshall": floydWarshall,
+		"johnson":        johnsonAllPairs,
+	} {
+		if _, err = allPairs(g.graph); !errors.Is(err, ErrNegativeCycle) {
+			t.Errorf("%s: Expected ErrNegativeCycle, got: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package graph
+
+import "math"
+
+// Heuristic estimates the distance between vertices for A* search,
+// it must never overestimate it for the path found to be the lightest.
+// A consistent heuristic, one that never decreases by more than the weight
+// of an edge, lets the search visit every vertex once, otherwise vertices
+// may be revisited once shorter paths to them are found
+type Heuristic func(from, to UVertex) float64
+
+// Point is implemented by vertices placed on a plane
+type Point interface {
+	Coordinates() (x, y float64)
+}
+
+// GeoPoint is implemented by vertices placed on the Earth,
+// latitude and longitude are given in degrees
+type GeoPoint interface {
+	LatLon() (lat, lon float64)
+}
+
+const earthRadius = 6371.0088 // km
+
+// Euclidean is the straight line distance between Points,
+// it is zero if any of vertices is not a Point
+func Euclidean(from, to UVertex) float64 {
+	var a, okA = from.(Point)
+	var b, okB = to.(Point)
+	if !okA || !okB {
+		return 0
+	}
+	var x1, y1 = a.Coordinates()
+	var x2, y2 = b.Coordinates()
+	return math.Hypot(x2-x1, y2-y1)
+}
+
+// Manhattan is the distance between Points moving along axes only,
+// it is zero if any of vertices is not a Point
+func Manhattan(from, to UVertex) float64 {
+	var a, okA = from.(Point)
+	var b, okB = to.(Point)
+	if !okA || !okB {
+		return 0
+	}
+	var x1, y1 = a.Coordinates()
+	var x2, y2 = b.Coordinates()
+	return math.Abs(x2-x1) + math.Abs(y2-y1)
+}
+
+// Haversine is the great-circle distance between GeoPoints in kilometres,
+// it is zero if any of vertices is not a GeoPoint
+func Haversine(from, to UVertex) float64 {
+	var a, okA = from.(GeoPoint)
+	var b, okB = to.(GeoPoint)
+	if !okA || !okB {
+		return 0
+	}
+	var lat1, lon1 = a.LatLon()
+	var lat2, lon2 = b.LatLon()
+	var rad = math.Pi / 180
+	var dLat = (lat2 - lat1) * rad
+	var dLon = (lon2 - lon1) * rad
+	var h = math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// AStar returns the lightest path between vertices exploring first those
+// the heuristic estimates closer to the target, weights must not be negative.
+// Nil heuristic makes it the same as Path
+func (g *UWGraph) AStar(from, to UVertex, h Heuristic) (*Path, error) {
+	if !g.HasBoth(from, to) {
+		return nil, ErrMissingVertex
+	}
+	return aStar(g.graph, g.graph[from.Id()], g.graph[to.Id()], h)
+}
+
+// AStar returns the lightest path between vertices exploring first those
+// the heuristic estimates closer to the target, weights must not be negative.
+// Nil heuristic makes it the same as Path
+func (g *DWGraph) AStar(from, to UVertex, h Heuristic) (*Path, error) {
+	if !g.HasBoth(from, to) {
+		return nil, ErrMissingVertex
+	}
+	return aStar(g.graph, g.graph[from.Id()], g.graph[to.Id()], h)
+}
+
+func aStar(graph map[string]UVertex, from, to UVertex, h Heuristic) (*Path, error) {
+	var s = &search{graph: graph}
+	if h != nil {
+		s.estimate = func(v UVertex) float64 {
+			return h(v, to)
+		}
+	}
+	var table, err = s.run(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return newPath(table, to), nil
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"container/list"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+type point struct {
+	uv
+	x, y float64
+}
+
+func newPoint(x, y int) *point {
+	return &point{
+		uv: uv{id: strconv.Itoa(x) + ":" + strconv.Itoa(y), vs: list.New()},
+		x:  float64(x),
+		y:  float64(y),
+	}
+}
+
+func (p *point) Coordinates() (float64, float64) {
+	return p.x, p.y
+}
+
+type geoPoint struct {
+	uv
+	lat, lon float64
+}
+
+func (p *geoPoint) LatLon() (float64, float64) {
+	return p.lat, p.lon
+}
+
+func TestHeuristics(t *testing.T) {
+	var a, b = newPoint(0, 0), newPoint(3, 4)
+	if d := Euclidean(a, b); d != 5 {
+		t.Errorf("Euclidean() = %v, want 5", d)
+	}
+	if d := Manhattan(a, b); d != 7 {
+		t.Errorf("Manhattan() = %v, want 7", d)
+	}
+	if d := Euclidean(a, newUV("A")); d != 0 {
+		t.Errorf("Euclidean() = %v, want 0", d)
+	}
+	var paris = &geoPoint{uv: uv{id: "Paris"}, lat: 48.8566, lon: 2.3522}
+	var london = &geoPoint{uv: uv{id: "London"}, lat: 51.5074, lon: -0.1278}
+	if d := Haversine(paris, london); math.Abs(d-343.5) > 1 {
+		t.Errorf("Haversine() = %v, want 343.5", d)
+	}
+}
+
+func TestUWGraph_AStar(t *testing.T) {
+	var rnd = rand.New(rand.NewSource(1))
+	var g = NewUWGraph()
+	var size = 10
+	var grid = make([][]*point, size)
+	for x := range grid {
+		grid[x] = make([]*point, size)
+		for y := range grid[x] {
+			grid[x][y] = newPoint(x, y)
+			g.Add(grid[x][y])
+		}
+	}
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			if x+1 < size && rnd.Intn(5) > 0 {
+				g.Connect(grid[x][y], grid[x+1][y], 1+rnd.Float64())
+			}
+			if y+1 < size && rnd.Intn(5) > 0 {
+				g.Connect(grid[x][y], grid[x][y+1], 1+rnd.Float64())
+			}
+		}
+	}
+	for i := 0; i < 50; i++ {
+		var from = grid[rnd.Intn(size)][rnd.Intn(size)]
+		var to = grid[rnd.Intn(size)][rnd.Intn(size)]
+		var want, wantErr = g.Path(from, to)
+		for name, h := range map[string]Heuristic{
+			"euclidean": Euclidean,
+			"manhattan": Manhattan,
+			"none":      nil,
+		} {
+			var got, err = g.AStar(from, to, h)
+			if err != wantErr {
+				t.Fatalf("%s: AStar(%s, %s) error = %v, want %v", name, from.Id(), to.Id(), err, wantErr)
+			}
+			if err == nil && math.Abs(got.Weight()-want.Weight()) > 1e-9 {
+				t.Errorf("%s: AStar(%s, %s) = %v, want %v", name, from.Id(), to.Id(), got.Weight(), want.Weight())
+			}
+		}
+	}
+	if _, err := g.AStar(grid[0][0], newUV("Q"), Euclidean); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+func TestDWGraph_AStar(t *testing.T) {
+	var g = mockDWGraph([]string{"S", "A", "B", "G"}, []struct {
+		from, to string
+		w        float64
+	}{
+		{"S", "A", 1}, {"S", "B", 2}, {"A", "B", 0.5}, {"B", "G", 3},
+	})
+	// admissible but not consistent, B is settled before
+	// the shorter path to it through A is found
+	var h = func(from, to UVertex) float64 {
+		if from.Id() == "A" {
+			return 3.5
+		}
+		return 0
+	}
+	var want, err = g.Path(newUV("S"), newUV("G"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, _ = g.AStar(newUV("S"), newUV("G"), h)
+	if got.Weight() != want.Weight() || pathIds(got) != "SABG" {
+		t.Errorf("AStar() = %s (%v), want SABG (%v)", pathIds(got), got.Weight(), want.Weight())
+	}
+}
@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+)
+
+var ErrNegativeCycle = errors.New("negative cycle")
+
+// NegativeCycleError describes a cycle of negative weight reachable
+// from the source, it matches ErrNegativeCycle with errors.Is
+type NegativeCycleError struct {
+	// Cycle lists vertices in the order of edges,
+	// the last vertex is connected to the first one
+	Cycle []UVertex
+}
+
+func (e *NegativeCycleError) Error() string {
+	var buff = &bytes.Buffer{}
+	buff.WriteString(ErrNegativeCycle.Error())
+	buff.WriteString(": ")
+	for _, v := range e.Cycle {
+		buff.WriteString(v.Id())
+		buff.WriteString(" -> ")
+	}
+	if len(e.Cycle) > 0 {
+		buff.WriteString(e.Cycle[0].Id())
+	}
+	return buff.String()
+}
+
+func (e *NegativeCycleError) Unwrap() error {
+	return ErrNegativeCycle
+}
+
+// BellmanFord returns the lightest path between vertices, unlike Path
+// it accepts negative weights. It fails with NegativeCycleError
+// if a cycle of negative weight is reachable from the source
+func (g *DWGraph) BellmanFord(from, to UVertex) (*Path, error) {
+	if !g.HasBoth(from, to) {
+		return nil, ErrMissingVertex
+	}
+	var table, err = spfa(g.graph, []UVertex{g.graph[from.Id()]})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := table[to.Id()]; !ok {
+		return nil, ErrNoPath
+	}
+	return newPath(table, g.graph[to.Id()]), nil
+}
+
+// spfa is Bellman-Ford algorithm relaxing edges of vertices whose distance
+// has changed only. Sources start at zero distance, a path having as many
+// edges as there are vertices proves a negative cycle
+func spfa(graph map[string]UVertex, sources []UVertex) (map[string]*row, error) {
+	var table = make(map[string]*row, len(graph))
+	var edges = make(map[string]int, len(graph))
+	var queued = newSet()
+	var queue = make([]UVertex, 0, len(sources))
+	var roots = newSet()
+	for _, v := range sources {
+		table[v.Id()] = &row{previous: v}
+		queued.add(v.Id())
+		roots.add(v.Id())
+		queue = append(queue, v)
+	}
+	for len(queue) > 0 {
+		var node = queue[0]
+		queue = queue[1:]
+		queued.remove(node.Id())
+		var dist = table[node.Id()].weight
+		for el := node.Edges().Front(); el != nil; el = el.Next() {
+			var edge = el.Value.(Edge)
+			var id = edge.To().Id()
+			var rec, ok = table[id]
+			if ok && rec.weight <= dist+edge.Weight() {
+				continue
+			}
+			if !ok {
+				rec = &row{}
+				table[id] = rec
+			}
+			rec.weight = dist + edge.Weight()
+			rec.previous = node
+			edges[id] = edges[node.Id()] + 1
+			if edges[id] >= len(graph) {
+				return nil, &NegativeCycleError{Cycle: negativeCycle(table, roots, edge.To())}
+			}
+			if !queued.contains(id) {
+				queued.add(id)
+				queue = append(queue, edge.To())
+			}
+		}
+	}
+	return table, nil
+}
+
+// negativeCycle finds a cycle following previous vertices from v,
+// every vertex is checked if v does not lead to one
+func negativeCycle(table map[string]*row, roots set, v UVertex) []UVertex {
+	if cycle := previousCycle(table, roots, v); cycle != nil {
+		return cycle
+	}
+	for _, rec := range table {
+		if cycle := previousCycle(table, roots, rec.previous); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// previousCycle stops at a source being previous to itself,
+// unless its distance has dropped below zero through a negative loop
+func previousCycle(table map[string]*row, roots set, v UVertex) []UVertex {
+	var seen = newSet()
+	for !seen.contains(v.Id()) {
+		seen.add(v.Id())
+		var rec = table[v.Id()]
+		if rec.previous.Equal(v) {
+			if roots.contains(v.Id()) && rec.weight >= 0 {
+				return nil
+			}
+			return []UVertex{v}
+		}
+		v = rec.previous
+	}
+	var cycle = []UVertex{v}
+	for u := table[v.Id()].previous; !u.Equal(v); u = table[u.Id()].previous {
+		cycle = append(cycle, u)
+	}
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
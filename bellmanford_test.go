@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func mockDWGraph(ids []string, edges []struct {
+	from, to string
+	w        float64
+}) *DWGraph {
+	var g = NewDWGraph()
+	for _, id := range ids {
+		g.Add(newUV(id))
+	}
+	for _, e := range edges {
+		g.Connect(newUV(e.from), newUV(e.to), e.w)
+	}
+	return g
+}
+
+func pathIds(p *Path) string {
+	var ids string
+	for e := p.Vertices().Front(); e != nil; e = e.Next() {
+		ids += e.Value.(UVertex).Id()
+	}
+	return ids
+}
+
+func TestDWGraph_BellmanFord(t *testing.T) {
+	var g = mockDWGraph([]string{"A", "B", "C", "D", "E"}, []struct {
+		from, to string
+		w        float64
+	}{
+		{"A", "B", 4}, {"A", "C", 2}, {"B", "C", -3},
+		{"C", "D", 2}, {"B", "D", 5}, {"D", "E", -1},
+	})
+	var tests = []struct {
+		name   string
+		from   string
+		to     string
+		ids    string
+		weight float64
+		err    error
+	}{
+		{name: "A-D", from: "A", to: "D", ids: "ABCD", weight: 3},
+		{name: "A-E", from: "A", to: "E", ids: "ABCDE", weight: 2},
+		{name: "B-B", from: "B", to: "B", ids: "B", weight: 0},
+		{name: "E-A", from: "E", to: "A", err: ErrNoPath},
+		{name: "A-Q", from: "A", to: "Q", err: ErrMissingVertex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var path, err = g.BellmanFord(newUV(tt.from), newUV(tt.to))
+			if err != tt.err {
+				t.Fatalf("Expected %v, got: %v", tt.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if ids := pathIds(path); ids != tt.ids || path.Weight() != tt.weight {
+				t.Errorf("BellmanFord() = %s:%v, want %s:%v", ids, path.Weight(), tt.ids, tt.weight)
+			}
+		})
+	}
+	if t.Failed() {
+		t.Log(g.repr())
+	}
+}
+
+func TestDWGraph_BellmanFord_NegativeCycle(t *testing.T) {
+	var g = mockDWGraph([]string{"A", "B", "C", "D", "E"}, []struct {
+		from, to string
+		w        float64
+	}{
+		{"A", "B", 1}, {"B", "C", 1}, {"C", "D", -3},
+		{"D", "B", 1}, {"D", "E", 1}, {"E", "E", -1},
+	})
+	var _, err = g.BellmanFord(newUV("A"), newUV("E"))
+	if !errors.Is(err, ErrNegativeCycle) {
+		t.Fatalf("Expected ErrNegativeCycle, got: %v", err)
+	}
+	var cycleErr *NegativeCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected NegativeCycleError, got: %v", err)
+	}
+	var weight float64
+	for i, v := range cycleErr.Cycle {
+		var next = cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]
+		var found bool
+		for e := g.graph[v.Id()].Edges().Front(); e != nil; e = e.Next() {
+			if edge := e.Value.(Edge); edge.To().Equal(next) {
+				weight += edge.Weight()
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("Not a cycle: %s", err)
+		}
+	}
+	if weight >= 0 {
+		t.Errorf("Cycle is not negative: %s", err)
+	}
+
+	g.Disconnect(newUV("C"), newUV("D"))
+	g.Connect(newUV("C"), newUV("D"), 1)
+	_, err = g.BellmanFord(newUV("A"), newUV("D"))
+	if !errors.As(err, &cycleErr) || len(cycleErr.Cycle) != 1 || cycleErr.Cycle[0].Id() != "E" {
+		t.Errorf("Expected negative loop of E, got: %v", err)
+	}
+}
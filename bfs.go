@@ -0,0 +1,90 @@
+package graph
+
+// SearchTree holds depth and parent of every vertex reached by BFS
+type SearchTree struct {
+	root   Vertex
+	order  []Vertex
+	depth  map[Vertex]int
+	parent map[Vertex]Vertex
+}
+
+func newSearchTree(root Vertex) *SearchTree {
+	return &SearchTree{
+		root:   root,
+		order:  []Vertex{root},
+		depth:  map[Vertex]int{root: 0},
+		parent: make(map[Vertex]Vertex),
+	}
+}
+
+func (t *SearchTree) Root() Vertex {
+	return t.root
+}
+
+// Vertices returns reached vertices in the order of discovery
+func (t *SearchTree) Vertices() []Vertex {
+	return t.order
+}
+
+// Depth returns number of edges between the root and v
+func (t *SearchTree) Depth(v Vertex) (int, bool) {
+	var d, ok = t.depth[v]
+	return d, ok
+}
+
+// Parent returns the vertex v has been reached from, the root has none
+func (t *SearchTree) Parent(v Vertex) (Vertex, bool) {
+	var p, ok = t.parent[v]
+	return p, ok
+}
+
+// PathTo returns vertices from the root to v, nil if v is not reached
+func (t *SearchTree) PathTo(v Vertex) []Vertex {
+	var d, ok = t.depth[v]
+	if !ok {
+		return nil
+	}
+	var path = make([]Vertex, d+1)
+	for i := d; i >= 0; i-- {
+		path[i] = v
+		v = t.parent[v]
+	}
+	return path
+}
+
+// BFSTree runs BFS from v visiting vertices at most maxDepth edges away,
+// negative maxDepth means no limit. Vertices are marked once enqueued,
+// so each of them is reached exactly once
+func (g DiGraph) BFSTree(v Vertex, maxDepth int) (*SearchTree, error) {
+	if !g.Has(v) {
+		return nil, ErrMissingVertex
+	}
+	return g.bfs(v, maxDepth, nil), nil
+}
+
+// bfs stops as soon as target is reached
+func (g DiGraph) bfs(v Vertex, maxDepth int, target Vertex) *SearchTree {
+	var t = newSearchTree(v)
+	if v == target {
+		return t
+	}
+	for i := 0; i < len(t.order); i++ {
+		var cv = t.order[i]
+		var depth = t.depth[cv]
+		if depth == maxDepth {
+			continue
+		}
+		for node := g[cv].head; node != nil; node = node.next {
+			if _, ok := t.depth[node.val]; ok {
+				continue
+			}
+			t.depth[node.val] = depth + 1
+			t.parent[node.val] = cv
+			t.order = append(t.order, node.val)
+			if node.val == target {
+				return t
+			}
+		}
+	}
+	return t
+}
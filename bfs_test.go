@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestDiGraph_BFSTree(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"K", "A", "B", "C", "D", "W", "X", "Y"},
+		[][2]string{
+			{"K", "A"}, {"K", "B"}, {"K", "C"}, {"A", "C"},
+			{"B", "C"}, {"C", "W"}, {"W", "X"}, {"X", "K"},
+			{"D", "K"},
+		},
+	)
+	var tests = []struct {
+		name     string
+		maxDepth int
+		order    string
+		depths   map[string]int
+		parents  map[string]string
+	}{
+		{
+			name:     "unlimited",
+			maxDepth: -1,
+			order:    "K A B C W X",
+			depths:   map[string]int{"K": 0, "A": 1, "C": 1, "W": 2, "X": 3},
+			parents:  map[string]string{"A": "K", "C": "K", "W": "C", "X": "W"},
+		},
+		{
+			name:     "limited",
+			maxDepth: 1,
+			order:    "K A B C",
+			depths:   map[string]int{"C": 1},
+			parents:  map[string]string{"C": "K"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tree, err = g.BFSTree(vs["K"], tt.maxDepth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ids := idsOf(tree.Vertices()); ids != tt.order {
+				t.Errorf("Vertices() = %s, want %s", ids, tt.order)
+			}
+			for id, want := range tt.depths {
+				if got, ok := tree.Depth(vs[id]); !ok || got != want {
+					t.Errorf("Depth(%s) = %d, want %d", id, got, want)
+				}
+			}
+			for id, want := range tt.parents {
+				if got, ok := tree.Parent(vs[id]); !ok || got.Id() != want {
+					t.Errorf("Parent(%s) = %v, want %s", id, got, want)
+				}
+			}
+			if _, ok := tree.Parent(vs["K"]); ok {
+				t.Error("Root must have no parent")
+			}
+			if _, ok := tree.Depth(vs["D"]); ok {
+				t.Error("D must not be reached")
+			}
+		})
+	}
+	var tree, _ = g.BFSTree(vs["K"], -1)
+	if ids := idsOf(tree.PathTo(vs["X"])); ids != "K C W X" {
+		t.Errorf("PathTo(X) = %s, want K C W X", ids)
+	}
+	if tree.PathTo(vs["Y"]) != nil {
+		t.Error("Expected no path to Y")
+	}
+	if _, err := g.BFSTree(&vertex{"Q"}, -1); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
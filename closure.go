@@ -0,0 +1,76 @@
+package graph
+
+// TransitiveClosure returns a graph where every vertex is connected
+// to all vertices reachable from it, a vertex lying on a cycle
+// is connected to itself
+func (g DiGraph) TransitiveClosure() DiGraph {
+	var x = g.indexed()
+	var res = NewDiGraph()
+	for _, v := range x.vertices {
+		res.Add(v)
+	}
+	for i, v := range x.vertices {
+		var seen = make([]bool, len(x.vertices))
+		var stack = append([]int(nil), x.adj[i]...)
+		for len(stack) > 0 {
+			var j = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if seen[j] {
+				continue
+			}
+			seen[j] = true
+			stack = append(stack, x.adj[j]...)
+		}
+		for j, ok := range seen {
+			if ok {
+				res.Connect(v, x.vertices[j])
+			}
+		}
+	}
+	return res
+}
+
+// TransitiveReduction returns a graph with the fewest edges having
+// the same reachability as the original one, it is defined for DAG only
+func (g DiGraph) TransitiveReduction() (DiGraph, error) {
+	if cycle, ok := g.FindCycle(); ok {
+		return NewDiGraph(), &CycleError{Cycle: cycle}
+	}
+	var x = g.indexed()
+	var res = NewDiGraph()
+	for _, v := range x.vertices {
+		res.Add(v)
+	}
+	var descendants = make([]map[int]bool, len(x.vertices))
+	for i, v := range x.vertices {
+		var indirect = newSet()
+		for _, w := range x.adj[i] {
+			for d := range x.descendants(w, descendants) {
+				indirect.add(d)
+			}
+		}
+		for _, w := range x.adj[i] {
+			if !indirect.contains(w) {
+				res.Connect(v, x.vertices[w])
+			}
+		}
+	}
+	return res, nil
+}
+
+// descendants returns vertices reachable from i in a DAG,
+// results are memoized in memo
+func (x *indexed) descendants(i int, memo []map[int]bool) map[int]bool {
+	if memo[i] != nil {
+		return memo[i]
+	}
+	var res = make(map[int]bool)
+	for _, w := range x.adj[i] {
+		res[w] = true
+		for d := range x.descendants(w, memo) {
+			res[d] = true
+		}
+	}
+	memo[i] = res
+	return res
+}
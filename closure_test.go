@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiGraph_TransitiveClosure(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E"},
+		[][2]string{
+			{"A", "B"}, {"B", "C"}, {"C", "B"}, {"D", "E"},
+		},
+	)
+	var closure = g.TransitiveClosure()
+	var tests = []struct {
+		from string
+		want string
+	}{
+		{from: "A", want: "B C"},
+		{from: "B", want: "B C"},
+		{from: "C", want: "B C"},
+		{from: "D", want: "E"},
+		{from: "E", want: ""},
+	}
+	for _, tt := range tests {
+		var ll, err = closure.Edges(vs[tt.from])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []Vertex
+		for v := range ll.Iterator() {
+			got = append(got, v)
+		}
+		if ids := idsOf(got); ids != tt.want {
+			t.Errorf("Edges(%s) = [%s], want [%s]", tt.from, ids, tt.want)
+		}
+	}
+	if t.Failed() {
+		t.Log(closure.repr())
+	}
+}
+
+func TestDiGraph_TransitiveReduction(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D"},
+		[][2]string{
+			{"A", "B"}, {"A", "C"}, {"A", "D"},
+			{"B", "C"}, {"B", "D"}, {"C", "D"},
+		},
+	)
+	var reduction, err = g.TransitiveReduction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want = map[string]string{
+		"A": "B",
+		"B": "C",
+		"C": "D",
+		"D": "",
+	}
+	for id, ids := range want {
+		var got []Vertex
+		for v := range reduction[vs[id]].Iterator() {
+			got = append(got, v)
+		}
+		if idsOf(got) != ids {
+			t.Errorf("Edges(%s) = [%s], want [%s]", id, idsOf(got), ids)
+		}
+	}
+	if t.Failed() {
+		t.Log(reduction.repr())
+	}
+
+	g.Connect(vs["D"], vs["A"])
+	if _, err = g.TransitiveReduction(); !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package graph
+
+// Timing holds the schedule of a vertex computed by CriticalPath
+type Timing struct {
+	EarliestStart  float64
+	EarliestFinish float64
+	LatestStart    float64
+	LatestFinish   float64
+	// Slack is how long the vertex can be delayed
+	// without delaying the whole schedule
+	Slack float64
+}
+
+// Schedule is the result of the critical path analysis
+type Schedule struct {
+	// Path is the chain of vertices determining the total Length
+	Path    []Vertex
+	Length  float64
+	Timings map[Vertex]Timing
+}
+
+// CriticalPath finds the longest path of DAG where every vertex takes
+// duration(v) to complete and may start only after all vertices connected
+// to it have finished. Optional lag adds a delay to every edge
+func (g DiGraph) CriticalPath(duration func(v Vertex) float64,
+	lag func(from, to Vertex) float64) (*Schedule, error) {
+	var order, err = g.SortedFunc(ById)
+	if err != nil {
+		return nil, err
+	}
+	if lag == nil {
+		lag = func(from, to Vertex) float64 {
+			return 0
+		}
+	}
+	var timings = make(map[Vertex]*Timing, len(g))
+	var previous = make(map[Vertex]Vertex)
+	for _, v := range order {
+		timings[v] = &Timing{}
+	}
+	var res = &Schedule{
+		Path:    make([]Vertex, 0),
+		Timings: make(map[Vertex]Timing, len(g)),
+	}
+	var last Vertex
+	for _, v := range order {
+		var t = timings[v]
+		t.EarliestFinish = t.EarliestStart + duration(v)
+		if last == nil || t.EarliestFinish > res.Length {
+			res.Length = t.EarliestFinish
+			last = v
+		}
+		for node := g[v].head; node != nil; node = node.next {
+			var start = t.EarliestFinish + lag(v, node.val)
+			var next = timings[node.val]
+			if _, ok := previous[node.val]; !ok && start >= next.EarliestStart ||
+				start > next.EarliestStart {
+				next.EarliestStart = start
+				previous[node.val] = v
+			}
+		}
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		var v = order[i]
+		var t = timings[v]
+		t.LatestFinish = res.Length
+		for node := g[v].head; node != nil; node = node.next {
+			var finish = timings[node.val].LatestStart - lag(v, node.val)
+			if finish < t.LatestFinish {
+				t.LatestFinish = finish
+			}
+		}
+		t.LatestStart = t.LatestFinish - duration(v)
+		t.Slack = t.LatestStart - t.EarliestStart
+		res.Timings[v] = *t
+	}
+	for v := last; v != nil; v = previous[v] {
+		res.Path = append(res.Path, v)
+	}
+	for i, j := 0, len(res.Path)-1; i < j; i, j = i+1, j-1 {
+		res.Path[i], res.Path[j] = res.Path[j], res.Path[i]
+	}
+	return res, nil
+}
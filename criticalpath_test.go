@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiGraph_CriticalPath(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E"},
+		[][2]string{
+			{"A", "B"}, {"A", "C"}, {"B", "D"},
+			{"C", "D"}, {"D", "E"},
+		},
+	)
+	var durations = map[string]float64{"A": 2, "B": 4, "C": 3, "D": 1, "E": 2}
+	var duration = func(v Vertex) float64 {
+		return durations[v.Id()]
+	}
+	var tests = []struct {
+		name   string
+		lag    func(from, to Vertex) float64
+		path   string
+		length float64
+		slack  map[string]float64
+	}{
+		{
+			name:   "no lag",
+			path:   "A B D E",
+			length: 9,
+			slack:  map[string]float64{"A": 0, "B": 0, "C": 1, "D": 0, "E": 0},
+		},
+		{
+			name: "lag",
+			lag: func(from, to Vertex) float64 {
+				if from.Id() == "C" && to.Id() == "D" {
+					return 2
+				}
+				return 0
+			},
+			path:   "A C D E",
+			length: 10,
+			slack:  map[string]float64{"A": 0, "B": 1, "C": 0, "D": 0, "E": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s, err = g.CriticalPath(duration, tt.lag)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ids := idsOf(s.Path); ids != tt.path {
+				t.Errorf("Path = %s, want %s", ids, tt.path)
+			}
+			if s.Length != tt.length {
+				t.Errorf("Length = %v, want %v", s.Length, tt.length)
+			}
+			for id, slack := range tt.slack {
+				if got := s.Timings[vs[id]].Slack; got != slack {
+					t.Errorf("Slack of %s = %v, want %v", id, got, slack)
+				}
+			}
+		})
+	}
+
+	var s, _ = g.CriticalPath(duration, nil)
+	var d = s.Timings[vs["D"]]
+	if d.EarliestStart != 6 || d.EarliestFinish != 7 || d.LatestStart != 6 || d.LatestFinish != 7 {
+		t.Errorf("Unexpected timing of D: %+v", d)
+	}
+
+	g.Connect(vs["E"], vs["A"])
+	if _, err := g.CriticalPath(duration, nil); !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package graph
+
+// Limits bound enumeration of cycles and paths, zero means no limit
+type Limits struct {
+	// MaxLength is the maximum number of edges in a result
+	MaxLength int
+	// MaxCount is the maximum number of results
+	MaxCount int
+}
+
+type johnson struct {
+	*indexed
+	radj    [][]int
+	limits  Limits
+	yield   func(cycle []Vertex) bool
+	start   int
+	allowed []bool
+	blocked []bool
+	blocks  []map[int]bool
+	stack   []int
+	count   int
+	stopped bool
+}
+
+// AllCycles enumerates elementary cycles of the graph using Johnson's
+// algorithm. Every cycle is passed to yield as soon as it is found,
+// starting with its vertex having the smallest Id, enumeration stops
+// once yield returns false or limits are reached
+func (g DiGraph) AllCycles(limits Limits, yield func(cycle []Vertex) bool) {
+	var x = g.indexed()
+	var j = &johnson{
+		indexed: x,
+		radj:    x.reversed(),
+		limits:  limits,
+		yield:   yield,
+		allowed: make([]bool, len(x.vertices)),
+		blocked: make([]bool, len(x.vertices)),
+		blocks:  make([]map[int]bool, len(x.vertices)),
+	}
+	for s := range x.vertices {
+		var component = j.component(s)
+		if len(component) == 0 {
+			continue
+		}
+		for i := range j.allowed {
+			j.allowed[i] = false
+		}
+		for _, v := range component {
+			j.allowed[v] = true
+			j.blocked[v] = false
+			j.blocks[v] = make(map[int]bool)
+		}
+		j.start = s
+		j.circuit(s)
+		if j.stopped {
+			return
+		}
+	}
+}
+
+// component returns strongly connected component of s in the subgraph
+// induced by vertices not less than s, trivial components are omitted
+func (j *johnson) component(s int) []int {
+	var forward = j.reach(s, j.adj)
+	var backward = j.reach(s, j.radj)
+	var res []int
+	for v := range forward {
+		if backward[v] {
+			res = append(res, v)
+		}
+	}
+	if len(res) == 1 {
+		for _, w := range j.adj[s] {
+			if w == s {
+				return res
+			}
+		}
+		return nil
+	}
+	return res
+}
+
+func (j *johnson) reach(s int, adj [][]int) map[int]bool {
+	var seen = map[int]bool{s: true}
+	var stack = []int{s}
+	for len(stack) > 0 {
+		var v = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, w := range adj[v] {
+			if w >= s && !seen[w] {
+				seen[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return seen
+}
+
+func (j *johnson) circuit(v int) bool {
+	var found bool
+	j.stack = append(j.stack, v)
+	j.blocked[v] = true
+	var deep = j.limits.MaxLength > 0 && len(j.stack) >= j.limits.MaxLength
+	for _, w := range j.adj[v] {
+		if !j.allowed[w] {
+			continue
+		}
+		if w == j.start {
+			j.emit()
+			found = true
+		} else if !deep && !j.blocked[w] && j.circuit(w) {
+			found = true
+		}
+		if j.stopped {
+			break
+		}
+	}
+	// vertices cut off by the length limit may still lead to the start,
+	// so they must not stay blocked
+	if found || deep {
+		j.unblock(v)
+	} else {
+		for _, w := range j.adj[v] {
+			if j.allowed[w] {
+				j.blocks[w][v] = true
+			}
+		}
+	}
+	j.stack = j.stack[:len(j.stack)-1]
+	return found || deep
+}
+
+func (j *johnson) unblock(v int) {
+	j.blocked[v] = false
+	for w := range j.blocks[v] {
+		delete(j.blocks[v], w)
+		if j.blocked[w] {
+			j.unblock(w)
+		}
+	}
+}
+
+func (j *johnson) emit() {
+	var cycle = make([]Vertex, len(j.stack))
+	for i, v := range j.stack {
+		cycle[i] = j.vertices[v]
+	}
+	j.count++
+	if !j.yield(cycle) || j.count == j.limits.MaxCount {
+		j.stopped = true
+	}
+}
@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestDiGraph_AllCycles(t *testing.T) {
+	var g, _ = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E"},
+		[][2]string{
+			{"A", "B"}, {"B", "C"}, {"C", "A"},
+			{"B", "A"}, {"C", "D"}, {"D", "B"},
+			{"D", "D"}, {"D", "E"},
+		},
+	)
+	var expected = map[string]bool{
+		"A B":   true,
+		"A B C": true,
+		"B C D": true,
+		"D":     true,
+	}
+	var found = make(map[string]bool)
+	g.AllCycles(Limits{}, func(cycle []Vertex) bool {
+		var ids = idsOf(cycle)
+		if !expected[ids] || found[ids] {
+			t.Errorf("Unexpected cycle: %s", ids)
+		}
+		found[ids] = true
+		return true
+	})
+	if len(found) != len(expected) {
+		t.Errorf("Expected %d cycles, got: %v", len(expected), found)
+	}
+	if t.Failed() {
+		t.Log(g.repr())
+	}
+}
+
+func TestDiGraph_AllCycles_Limits(t *testing.T) {
+	var g, _ = mockDiGraph(
+		[]string{"A", "B", "C", "D"},
+		[][2]string{
+			{"A", "B"}, {"B", "A"}, {"B", "C"}, {"C", "A"},
+			{"C", "D"}, {"D", "A"}, {"A", "D"},
+		},
+	)
+	var tests = []struct {
+		name   string
+		limits Limits
+		want   int
+	}{
+		{name: "unlimited", limits: Limits{}, want: 4},
+		{name: "length 2", limits: Limits{MaxLength: 2}, want: 2},
+		{name: "length 3", limits: Limits{MaxLength: 3}, want: 3},
+		{name: "count 2", limits: Limits{MaxCount: 2}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var count int
+			g.AllCycles(tt.limits, func(cycle []Vertex) bool {
+				if tt.limits.MaxLength > 0 && len(cycle) > tt.limits.MaxLength {
+					t.Errorf("Cycle is too long: %s", idsOf(cycle))
+				}
+				count++
+				return true
+			})
+			if count != tt.want {
+				t.Errorf("AllCycles() = %d cycles, want %d", count, tt.want)
+			}
+		})
+	}
+	var count int
+	g.AllCycles(Limits{}, func(cycle []Vertex) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("Enumeration has not been stopped, got %d cycles", count)
+	}
+}
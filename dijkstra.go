@@ -0,0 +1,76 @@
+package graph
+
+// search finds the lightest paths using Dijkstra's algorithm
+type search struct {
+	graph map[string]UVertex
+	// weight replaces weights of edges if set
+	weight func(e Edge) float64
+	// estimate turns the search into A* if set, it must not
+	// overestimate the distance left to the target
+	estimate func(v UVertex) float64
+	// skip hides edges from the search if set
+	skip func(e Edge) bool
+}
+
+func (s *search) weightOf(e Edge) float64 {
+	if s.weight != nil {
+		return s.weight(e)
+	}
+	return e.Weight()
+}
+
+func (s *search) estimateOf(v UVertex) float64 {
+	if s.estimate != nil {
+		return s.estimate(v)
+	}
+	return 0
+}
+
+// run settles vertices in the order of distance from the source until
+// the target is settled, all reachable vertices are settled if the target
+// is nil. The queue holds an edge per reached vertex, weight of the edge
+// is the distance of its target found so far plus the estimate. It fails
+// with ErrNegativeWeight on a negative edge. With an estimate a settled
+// vertex is queued again once a shorter distance to it is found, which
+// happens only if the estimate is not consistent
+func (s *search) run(from, to UVertex) (map[string]*row, error) {
+	var table = make(map[string]*row)
+	var dist = map[string]float64{from.Id(): 0}
+	var queue = NewFixedHeap(len(s.graph))
+	queue.Push(newEdge(from, from, s.estimateOf(from)))
+	for queue.Len() > 0 {
+		var e = queue.Pop()
+		var node = e.To()
+		table[node.Id()] = &row{
+			previous: e.From(),
+			weight:   dist[node.Id()],
+		}
+		if to != nil && node.Equal(to) {
+			return table, nil
+		}
+		for el := node.Edges().Front(); el != nil; el = el.Next() {
+			var edge = el.Value.(Edge)
+			var id = edge.To().Id()
+			if s.skip != nil && s.skip(edge) {
+				continue
+			}
+			var w = s.weightOf(edge)
+			if w < 0 {
+				return nil, ErrNegativeWeight
+			}
+			if _, settled := table[id]; settled && s.estimate == nil {
+				continue
+			}
+			var d = dist[node.Id()] + w
+			if old, ok := dist[id]; ok && old <= d {
+				continue
+			}
+			dist[id] = d
+			queue.Update(newEdge(node, edge.To(), d+s.estimateOf(edge.To())))
+		}
+	}
+	if to != nil {
+		return nil, ErrNoPath
+	}
+	return table, nil
+}
@@ -0,0 +1,159 @@
+package graph
+
+// DominatorTree holds immediate dominators of vertices reachable from
+// the root, vertex A dominates B if every path from the root to B goes
+// through A
+type DominatorTree struct {
+	root     Vertex
+	idom     map[Vertex]Vertex
+	children map[Vertex][]Vertex
+	frontier map[Vertex][]Vertex
+}
+
+func (t *DominatorTree) Root() Vertex {
+	return t.root
+}
+
+// Idom returns immediate dominator of v, the root
+// and unreachable vertices have none
+func (t *DominatorTree) Idom(v Vertex) (Vertex, bool) {
+	var d, ok = t.idom[v]
+	if !ok || v == t.root {
+		return nil, false
+	}
+	return d, true
+}
+
+// Children returns vertices immediately dominated by v sorted by Id
+func (t *DominatorTree) Children(v Vertex) []Vertex {
+	return t.children[v]
+}
+
+// Frontier returns dominance frontier of v sorted by Id
+func (t *DominatorTree) Frontier(v Vertex) []Vertex {
+	return t.frontier[v]
+}
+
+// Dominates reports whether a dominates b, every vertex dominates itself
+func (t *DominatorTree) Dominates(a, b Vertex) bool {
+	if _, ok := t.idom[b]; !ok {
+		return false
+	}
+	for b != t.root {
+		if b == a {
+			return true
+		}
+		b = t.idom[b]
+	}
+	return a == t.root
+}
+
+// Dominators computes dominator tree and dominance frontiers of vertices
+// reachable from root using Cooper-Harvey-Kennedy algorithm
+func (g DiGraph) Dominators(root Vertex) (*DominatorTree, error) {
+	if !g.Has(root) {
+		return nil, ErrMissingVertex
+	}
+	var postorder []Vertex
+	var number = make(map[Vertex]int)
+	g.postorder(root, newSet(), number, &postorder)
+	var preds = make(map[Vertex][]Vertex, len(postorder))
+	for _, v := range postorder {
+		for node := g[v].head; node != nil; node = node.next {
+			preds[node.val] = append(preds[node.val], v)
+		}
+	}
+
+	var doms = make([]int, len(postorder))
+	for i := range doms {
+		doms[i] = -1
+	}
+	var start = number[root]
+	doms[start] = start
+	for changed := true; changed; {
+		changed = false
+		for i := len(postorder) - 2; i >= 0; i-- {
+			var idom = -1
+			for _, p := range preds[postorder[i]] {
+				var j = number[p]
+				if doms[j] == -1 {
+					continue
+				}
+				if idom == -1 {
+					idom = j
+				} else {
+					idom = intersect(doms, idom, j)
+				}
+			}
+			if doms[i] != idom {
+				doms[i] = idom
+				changed = true
+			}
+		}
+	}
+
+	var t = &DominatorTree{
+		root:     root,
+		idom:     make(map[Vertex]Vertex, len(postorder)),
+		children: make(map[Vertex][]Vertex),
+		frontier: make(map[Vertex][]Vertex),
+	}
+	for i, v := range postorder {
+		t.idom[v] = postorder[doms[i]]
+		if v != root {
+			t.children[t.idom[v]] = append(t.children[t.idom[v]], v)
+		}
+	}
+	var frontier = make(map[Vertex]set)
+	for _, v := range postorder {
+		if len(preds[v]) < 2 {
+			continue
+		}
+		for _, p := range preds[v] {
+			for runner := p; runner != t.idom[v]; runner = t.idom[runner] {
+				if frontier[runner] == nil {
+					frontier[runner] = newSet()
+				}
+				frontier[runner].add(v)
+				if runner == root {
+					break
+				}
+			}
+		}
+	}
+	for v, vs := range frontier {
+		for w := range vs {
+			t.frontier[v] = append(t.frontier[v], w.(Vertex))
+		}
+		sortVertices(t.frontier[v])
+	}
+	for _, vs := range t.children {
+		sortVertices(vs)
+	}
+	return t, nil
+}
+
+func (g DiGraph) postorder(v Vertex, visited set, number map[Vertex]int, out *[]Vertex) {
+	visited.add(v)
+	for node := g[v].head; node != nil; node = node.next {
+		if !visited.contains(node.val) {
+			g.postorder(node.val, visited, number, out)
+		}
+	}
+	number[v] = len(*out)
+	*out = append(*out, v)
+}
+
+// intersect walks up the dominator tree from two vertices given by their
+// postorder numbers until the paths meet
+func intersect(doms []int, a, b int) int {
+	for a != b {
+		for a < b {
+			a = doms[a]
+		}
+		for b < a {
+			b = doms[b]
+		}
+	}
+	return a
+}
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestDiGraph_Dominators(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"R", "A", "B", "C", "D", "E", "X"},
+		[][2]string{
+			{"R", "A"}, {"A", "B"}, {"A", "C"}, {"B", "D"},
+			{"C", "D"}, {"D", "E"}, {"E", "A"}, {"X", "E"},
+		},
+	)
+	var tree, err = g.Dominators(vs["R"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var idoms = map[string]string{
+		"A": "R",
+		"B": "A",
+		"C": "A",
+		"D": "A",
+		"E": "D",
+	}
+	for id, want := range idoms {
+		var idom, ok = tree.Idom(vs[id])
+		if !ok || idom.Id() != want {
+			t.Errorf("Idom(%s) = %v, want %s", id, idom, want)
+		}
+	}
+	if _, ok := tree.Idom(vs["R"]); ok {
+		t.Error("Root must have no immediate dominator")
+	}
+	if _, ok := tree.Idom(vs["X"]); ok {
+		t.Error("Unreachable vertex must have no immediate dominator")
+	}
+	if ids := idsOf(tree.Children(vs["A"])); ids != "B C D" {
+		t.Errorf("Children(A) = %s, want B C D", ids)
+	}
+	var frontiers = map[string]string{
+		"R": "",
+		"A": "A",
+		"B": "D",
+		"C": "D",
+		"D": "A",
+		"E": "A",
+	}
+	for id, want := range frontiers {
+		if ids := idsOf(tree.Frontier(vs[id])); ids != want {
+			t.Errorf("Frontier(%s) = %s, want %s", id, ids, want)
+		}
+	}
+	if !tree.Dominates(vs["A"], vs["E"]) || !tree.Dominates(vs["E"], vs["E"]) ||
+		tree.Dominates(vs["B"], vs["D"]) || tree.Dominates(vs["A"], vs["X"]) {
+		t.Error("Unexpected dominance")
+	}
+	if _, err = g.Dominators(&vertex{"Q"}); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrFailedDependency = errors.New("dependency failed")
+var ErrExecutionStopped = errors.New("execution stopped")
+
+// FailurePolicy tells Execute how to proceed once a task fails
+type FailurePolicy int
+
+const (
+	// StopOnFailure lets running tasks finish and starts no new ones
+	StopOnFailure FailurePolicy = iota
+	// ContinueOnFailure skips only tasks depending on the failed one
+	ContinueOnFailure
+)
+
+type TaskStatus int
+
+const (
+	TaskSucceeded TaskStatus = iota
+	TaskFailed
+	TaskSkipped
+)
+
+func (s TaskStatus) String() string {
+	switch s {
+	case TaskSucceeded:
+		return "succeeded"
+	case TaskFailed:
+		return "failed"
+	case TaskSkipped:
+		return "skipped"
+	}
+	return "unknown"
+}
+
+// TaskResult holds the outcome of a task, Err is the error returned
+// by a failed task or the reason a task has been skipped
+type TaskResult struct {
+	Status TaskStatus
+	Err    error
+}
+
+type taskDone struct {
+	v   Vertex
+	err error
+}
+
+// Execute runs task for every vertex of DAG, a vertex is run once all
+// vertices connected to it have succeeded, at most workers tasks run
+// at the same time. It returns results of every vertex and the first
+// error returned by a task or ctx.Err() if ctx is done before all
+// tasks are started
+func (g DiGraph) Execute(ctx context.Context, workers int, policy FailurePolicy,
+	task func(v Vertex) error) (map[Vertex]TaskResult, error) {
+	if cycle, ok := g.FindCycle(); ok {
+		return make(map[Vertex]TaskResult), &CycleError{Cycle: cycle}
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	var results = make(map[Vertex]TaskResult, len(g))
+	var degrees = g.inDegrees()
+	var ready []Vertex
+	for _, v := range g.order() {
+		if degrees[v] == 0 {
+			ready = append(ready, v)
+		}
+	}
+	var done = make(chan taskDone)
+	var running int
+	var stopped bool
+	var failure error
+	for {
+		for !stopped && running < workers && len(ready) > 0 {
+			if ctx.Err() != nil {
+				break
+			}
+			var v = ready[0]
+			ready = ready[1:]
+			running++
+			go func(v Vertex) {
+				done <- taskDone{v: v, err: task(v)}
+			}(v)
+		}
+		if running == 0 {
+			break
+		}
+		var d taskDone
+		if stopped {
+			d = <-done
+		} else {
+			select {
+			case d = <-done:
+			case <-ctx.Done():
+				stopped = true
+				if failure == nil {
+					failure = ctx.Err()
+				}
+				continue
+			}
+		}
+		running--
+		if d.err != nil {
+			results[d.v] = TaskResult{Status: TaskFailed, Err: d.err}
+			if failure == nil {
+				failure = d.err
+			}
+			if policy == StopOnFailure {
+				stopped = true
+			} else {
+				g.skipDependents(d.v, results)
+			}
+			continue
+		}
+		results[d.v] = TaskResult{Status: TaskSucceeded}
+		for node := g[d.v].head; node != nil; node = node.next {
+			degrees[node.val]--
+			if _, ok := results[node.val]; !ok && degrees[node.val] == 0 {
+				ready = append(ready, node.val)
+			}
+		}
+	}
+	if failure == nil && ctx.Err() != nil && len(results) < len(g) {
+		failure = ctx.Err()
+	}
+	var reason = ErrExecutionStopped
+	if ctx.Err() != nil {
+		reason = ctx.Err()
+	}
+	for v := range g {
+		if _, ok := results[v]; !ok {
+			results[v] = TaskResult{Status: TaskSkipped, Err: reason}
+		}
+	}
+	return results, failure
+}
+
+func (g DiGraph) skipDependents(v Vertex, results map[Vertex]TaskResult) {
+	for node := g[v].head; node != nil; node = node.next {
+		if _, ok := results[node.val]; ok {
+			continue
+		}
+		results[node.val] = TaskResult{Status: TaskSkipped, Err: ErrFailedDependency}
+		g.skipDependents(node.val, results)
+	}
+}
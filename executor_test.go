@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDiGraph_Execute(t *testing.T) {
+	var g, _ = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E", "F", "G"},
+		[][2]string{
+			{"A", "C"}, {"B", "C"}, {"C", "D"},
+			{"C", "E"}, {"D", "F"}, {"E", "F"},
+		},
+	)
+	var mu sync.Mutex
+	var finished = newSet()
+	var running, maxRunning int
+	// the first tasks wait for each other, so that the two workers overlap
+	var overlapped = make(chan struct{})
+	var results, err = g.Execute(context.Background(), 2, StopOnFailure, func(v Vertex) error {
+		mu.Lock()
+		for parent, ll := range g {
+			for node := ll.head; node != nil; node = node.next {
+				if node.val == v && !finished.contains(parent) {
+					t.Errorf("%s started before %s", v.Id(), parent.Id())
+				}
+			}
+		}
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		if running == 2 && len(finished) == 0 {
+			close(overlapped)
+		}
+		mu.Unlock()
+
+		select {
+		case <-overlapped:
+		case <-time.After(time.Second):
+			t.Errorf("%s did not overlap with another task", v.Id())
+		}
+
+		mu.Lock()
+		running--
+		finished.add(v)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maxRunning != 2 {
+		t.Errorf("Expected 2 running tasks at most, got: %d", maxRunning)
+	}
+	for v, res := range results {
+		if res.Status != TaskSucceeded {
+			t.Errorf("Unexpected status of %s: %s", v.Id(), res.Status)
+		}
+	}
+	if len(results) != len(g) {
+		t.Errorf("Expected %d results, got: %d", len(g), len(results))
+	}
+}
+
+func TestDiGraph_Execute_Failure(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E"},
+		[][2]string{
+			{"A", "B"}, {"B", "C"}, {"A", "D"}, {"D", "E"},
+		},
+	)
+	var errTask = errors.New("task failed")
+	var task = func(v Vertex) error {
+		if v.Id() == "B" {
+			return errTask
+		}
+		return nil
+	}
+	var tests = []struct {
+		name   string
+		policy FailurePolicy
+		want   map[string]TaskResult
+	}{
+		{
+			name:   "continue",
+			policy: ContinueOnFailure,
+			want: map[string]TaskResult{
+				"A": {Status: TaskSucceeded},
+				"B": {Status: TaskFailed, Err: errTask},
+				"C": {Status: TaskSkipped, Err: ErrFailedDependency},
+				"D": {Status: TaskSucceeded},
+				"E": {Status: TaskSucceeded},
+			},
+		},
+		{
+			name:   "stop",
+			policy: StopOnFailure,
+			want: map[string]TaskResult{
+				"A": {Status: TaskSucceeded},
+				"B": {Status: TaskFailed, Err: errTask},
+				"C": {Status: TaskSkipped, Err: ErrExecutionStopped},
+				"D": {Status: TaskSkipped, Err: ErrExecutionStopped},
+				"E": {Status: TaskSkipped, Err: ErrExecutionStopped},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var results, err = g.Execute(context.Background(), 1, tt.policy, task)
+			if err != errTask {
+				t.Errorf("Expected task error, got: %v", err)
+			}
+			for id, want := range tt.want {
+				if got := results[vs[id]]; got != want {
+					t.Errorf("Result of %s = %v, want %v", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDiGraph_Execute_Canceled(t *testing.T) {
+	var g, vs = mockDiGraph([]string{"A", "B"}, [][2]string{{"A", "B"}})
+	var ctx, cancel = context.WithCancel(context.Background())
+	var results, err = g.Execute(ctx, 4, ContinueOnFailure, func(v Vertex) error {
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+	if results[vs["A"]].Status != TaskSucceeded {
+		t.Errorf("Expected A to succeed, got: %v", results[vs["A"]])
+	}
+	if res := results[vs["B"]]; res.Status != TaskSkipped || res.Err != context.Canceled {
+		t.Errorf("Expected B to be skipped, got: %v", res)
+	}
+
+	g.Connect(vs["B"], vs["A"])
+	if _, err = g.Execute(context.Background(), 1, StopOnFailure, func(Vertex) error {
+		return nil
+	}); !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+}
@@ -9,16 +9,21 @@ import (
 var ErrHeapOverflow = errors.New("heap overflow")
 var ErrNoValue = errors.New("no value to return")
 
+// FixedHeap is a min heap of edges ordered by weight, it tracks
+// positions of edges by Id of their targets, so a queued edge
+// can be replaced with a lighter one leading to the same vertex
 type FixedHeap struct {
-	arr []Edge
-	len int
-	cap int
+	arr   []Edge
+	len   int
+	cap   int
+	index map[string]int
 }
 
 func NewFixedHeap(size int) *FixedHeap {
 	return &FixedHeap{
-		arr: make([]Edge, size+1),
-		cap: size,
+		arr:   make([]Edge, size+1),
+		cap:   size,
+		index: make(map[string]int, size),
 	}
 }
 
@@ -28,6 +33,12 @@ func (p *FixedHeap) less(i, j int) bool {
 
 func (p *FixedHeap) swap(i, j int) {
 	p.arr[i], p.arr[j] = p.arr[j], p.arr[i]
+	if id := p.arr[i].To().Id(); p.index[id] == j {
+		p.index[id] = i
+	}
+	if id := p.arr[j].To().Id(); p.index[id] == i {
+		p.index[id] = j
+	}
 }
 
 func (p *FixedHeap) swim(i int) {
@@ -83,25 +94,55 @@ func (p *FixedHeap) Len() int {
 	return p.len
 }
 
+// Push queues e, it keeps at most one edge leading to every vertex,
+// so it is the same as Update
 func (p *FixedHeap) Push(e Edge) {
+	p.Update(e)
+}
+
+func (p *FixedHeap) insert(e Edge) {
 	if p.cap <= p.len {
 		panic(ErrHeapOverflow)
 	}
 	p.len++
 	p.arr[p.len] = e
+	p.index[e.To().Id()] = p.len
 	p.swim(p.len)
 }
 
+// Contains reports whether an edge leading to v is queued
+func (p *FixedHeap) Contains(v UVertex) bool {
+	var _, ok = p.index[v.Id()]
+	return ok
+}
+
+// Update pushes e unless an edge leading to the same vertex is queued,
+// such an edge is replaced with e if e is lighter. It reports whether
+// the heap has changed
+func (p *FixedHeap) Update(e Edge) bool {
+	var i, ok = p.index[e.To().Id()]
+	if !ok {
+		p.insert(e)
+		return true
+	}
+	if e.Weight() >= p.arr[i].Weight() {
+		return false
+	}
+	p.arr[i] = e
+	p.swim(i)
+	return true
+}
+
 func (p *FixedHeap) Pop() Edge {
 	if p.len <= 0 {
 		panic(ErrNoValue)
 	}
-	if p.len == 1 {
-		p.len--
-		return p.arr[1]
-	}
 	var front = p.arr[1]
 	p.swap(1, p.len)
+	if id := front.To().Id(); p.index[id] == p.len {
+		delete(p.index, id)
+	}
+	p.arr[p.len] = nil
 	p.len--
 	p.sink(1)
 	return front
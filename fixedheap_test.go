@@ -120,3 +120,53 @@ func TestFixedHeap_Push(t *testing.T) {
 		t.Log(p.repr())
 	}
 }
+
+func TestFixedHeap_Update(t *testing.T) {
+	var h = NewFixedHeap(4)
+	var edges = []Edge{
+		newEdge(newUV("O"), newUV("A"), 4),
+		newEdge(newUV("O"), newUV("B"), 3),
+		newEdge(newUV("O"), newUV("C"), 5),
+		newEdge(newUV("X"), newUV("C"), 1),
+		newEdge(newUV("X"), newUV("A"), 6),
+		newEdge(newUV("X"), newUV("D"), 2),
+	}
+	var changed = []bool{true, true, true, true, false, true}
+	for i, e := range edges {
+		if got := h.Update(e); got != changed[i] {
+			t.Errorf("Update(%s) = %v, want %v", e.To().Id(), got, changed[i])
+		}
+	}
+	if h.Len() != 4 || !h.Contains(newUV("A")) {
+		t.Fatalf("Unexpected heap: %s", h.repr())
+	}
+	var expected = []Edge{edges[3], edges[5], edges[1], edges[0]}
+	for _, want := range expected {
+		if got := h.Pop(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Pop() = %v, want %v", got, want)
+		}
+	}
+	if h.Contains(newUV("A")) {
+		t.Error("Popped edge is still indexed")
+	}
+}
+
+func TestFixedHeap_Push_SameTarget(t *testing.T) {
+	var h = NewFixedHeap(2)
+	var light = newEdge(newUV("O"), newUV("A"), 1)
+	var heavy = newEdge(newUV("X"), newUV("A"), 2)
+	h.Push(light)
+	h.Push(heavy)
+	if h.Len() != 1 || !h.Contains(newUV("A")) {
+		t.Fatalf("Unexpected heap: %s", h.repr())
+	}
+	if h.Update(heavy) {
+		t.Error("Update() replaced a lighter edge")
+	}
+	if got := h.Pop(); !reflect.DeepEqual(got, light) {
+		t.Errorf("Pop() = %v, want %v", got, light)
+	}
+	if h.Len() != 0 || h.Contains(newUV("A")) {
+		t.Errorf("Unexpected heap: %s", h.repr())
+	}
+}
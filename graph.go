@@ -2,13 +2,40 @@ package graph
 
 import (
 	"bytes"
-	"container/list"
+	"context"
 	"errors"
+	"sort"
 )
 
 var ErrMissingVertex = errors.New("vertex is missing")
 var ErrCyclicGraph = errors.New("cyclic graph")
 
+// CycleError describes a cycle which prevents an algorithm from running,
+// it matches ErrCyclicGraph with errors.Is
+type CycleError struct {
+	// Cycle lists vertices in the order of edges,
+	// the last vertex is connected to the first one
+	Cycle []Vertex
+}
+
+func (e *CycleError) Error() string {
+	var buff = &bytes.Buffer{}
+	buff.WriteString(ErrCyclicGraph.Error())
+	buff.WriteString(": ")
+	for _, v := range e.Cycle {
+		buff.WriteString(v.Id())
+		buff.WriteString(" -> ")
+	}
+	if len(e.Cycle) > 0 {
+		buff.WriteString(e.Cycle[0].Id())
+	}
+	return buff.String()
+}
+
+func (e *CycleError) Unwrap() error {
+	return ErrCyclicGraph
+}
+
 type Vertex interface {
 	Id() string
 	Repr() string
@@ -68,13 +95,71 @@ func (g DiGraph) Disconnect(from, to Vertex) error {
 	return nil
 }
 
+// order returns vertices sorted by Id, algorithms that walk
+// the whole graph start from it to produce reproducible results
+func (g DiGraph) order() []Vertex {
+	var vs = make([]Vertex, 0, len(g))
+	for v := range g {
+		vs = append(vs, v)
+	}
+	sortVertices(vs)
+	return vs
+}
+
+func sortVertices(vs []Vertex) {
+	sort.Slice(vs, func(i, j int) bool {
+		return vs[i].Id() < vs[j].Id()
+	})
+}
+
+// indexed is a snapshot of DiGraph where vertices are replaced
+// with their positions in DiGraph.order and parallel edges are dropped
+type indexed struct {
+	vertices []Vertex
+	index    map[Vertex]int
+	adj      [][]int
+}
+
+func (g DiGraph) indexed() *indexed {
+	var res = &indexed{
+		vertices: g.order(),
+		index:    make(map[Vertex]int, len(g)),
+	}
+	for i, v := range res.vertices {
+		res.index[v] = i
+	}
+	res.adj = make([][]int, len(res.vertices))
+	for i, v := range res.vertices {
+		var connected = newSet()
+		for node := g[v].head; node != nil; node = node.next {
+			var j = res.index[node.val]
+			if !connected.contains(j) {
+				connected.add(j)
+				res.adj[i] = append(res.adj[i], j)
+			}
+		}
+	}
+	return res
+}
+
+func (x *indexed) reversed() [][]int {
+	var radj = make([][]int, len(x.adj))
+	for i, ns := range x.adj {
+		for _, j := range ns {
+			radj[j] = append(radj[j], i)
+		}
+	}
+	return radj
+}
+
 func (g DiGraph) repr() string {
 	var buff = &bytes.Buffer{}
 	for vertex, ll := range g {
 		buff.WriteString(vertex.Id())
 		buff.WriteString(" is connected with [ ")
-		for v := range ll.Iterator() {
-			buff.WriteString(v.Id())
+		var it = ll.Iter()
+		for it.Next() {
+			buff.WriteString(it.Vertex().Id())
 			buff.WriteString(" ")
 		}
 		buff.WriteString("]\n")
@@ -83,102 +168,94 @@ func (g DiGraph) repr() string {
 }
 
 func (g DiGraph) DFS(v Vertex) <-chan Vertex {
+	return g.DFSContext(context.Background(), v)
+}
+
+func (g DiGraph) BFS(v Vertex) <-chan Vertex {
+	return g.BFSContext(context.Background(), v)
+}
+
+// DFSContext is DFS which stops once ctx is done, the channel is closed
+// then and the producing goroutine exits even if nobody reads it anymore
+func (g DiGraph) DFSContext(ctx context.Context, v Vertex) <-chan Vertex {
 	var out = make(chan Vertex, 10)
-	go g.traverse(v, out, false)
+	go g.traverse(ctx, v, out, false)
 	return out
 }
 
-func (g DiGraph) BFS(v Vertex) <-chan Vertex {
+// BFSContext is BFS which stops once ctx is done, the channel is closed
+// then and the producing goroutine exits even if nobody reads it anymore
+func (g DiGraph) BFSContext(ctx context.Context, v Vertex) <-chan Vertex {
 	var out = make(chan Vertex, 10)
-	go g.traverse(v, out, true)
+	go g.traverse(ctx, v, out, true)
 	return out
 }
 
-func (g DiGraph) traverse(v Vertex, out chan<- Vertex, useQueue bool) {
+func (g DiGraph) traverse(ctx context.Context, v Vertex, out chan<- Vertex, useQueue bool) {
 	defer close(out)
-	if !g.Has(v) {
-		return
-	}
-	var visited = newSet()
-	var ll = list.New()
-	ll.PushBack(v)
-	for ll.Len() > 0 {
-		var el *list.Element
-		if useQueue {
-			el = ll.Front()
-		} else {
-			el = ll.Back()
-		}
-		var cv = el.Value.(Vertex)
-		ll.Remove(el)
-		out <- cv
-		visited.add(cv)
-		for nv := range g[cv].Iterator() {
-			if !visited.contains(nv) {
-				ll.PushBack(nv)
-			}
+	var it = g.newTraversal(v, useQueue)
+	for it.Next() {
+		select {
+		case out <- it.Vertex():
+		case <-ctx.Done():
+			return
 		}
 	}
 }
 
 // Sorted implements topological sorting on directed acyclic graph (DAG)
 func (g DiGraph) Sorted() ([]Vertex, error) {
-	if g.Cyclic() {
-		return make([]Vertex, 0), ErrCyclicGraph
-	}
-	var out = list.New()
-	var visited = newSet()
-	for parent, _ := range g {
-		g.sorted(parent, visited, out)
+	var visitor = &topoVisitor{finished: make([]Vertex, 0, len(g))}
+	g.Walk(visitor)
+	if visitor.cycle != nil {
+		return make([]Vertex, 0), &CycleError{Cycle: visitor.cycle}
 	}
-	var result = make([]Vertex, out.Len())
-	for out.Len() > 0 {
-		result[out.Len()-1] = out.Back().Value.(Vertex)
-		out.Remove(out.Back())
+	var result = visitor.finished
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
 	}
 	return result, nil
 }
 
-func (g DiGraph) sorted(v Vertex, visited set, out *list.List) {
-	if !g.Has(v) || visited.contains(v) {
-		return
-	}
-	var node = g[v].head
-	for node != nil {
-		g.sorted(node.val, visited, out)
-		node = node.next
-	}
-	out.PushFront(v)
-	visited.add(v)
+func (g DiGraph) Cyclic() bool {
+	var _, ok = g.FindCycle()
+	return ok
 }
 
-func (g DiGraph) Cyclic() bool {
-	var visited = newSet()
-	var visiting = newSet()
-	for parent, _ := range g {
-		if g.cyclic(parent, visiting, visited) {
-			return true
-		}
-	}
-	return false
+// FindCycle returns vertices of a cycle in the order of edges,
+// the last vertex is connected to the first one
+func (g DiGraph) FindCycle() ([]Vertex, bool) {
+	var visitor = &topoVisitor{}
+	g.Walk(visitor)
+	return visitor.cycle, visitor.cycle != nil
 }
 
-func (g DiGraph) cyclic(v Vertex, visiting, visited set) bool {
-	if visiting.contains(v) {
-		return true
-	}
-	if !g.Has(v) || visited.contains(v) {
-		return false
-	}
-	visiting.add(v)
-	var node = g[v].head
-	for node != nil {
-		if g.cyclic(node.val, visiting, visited) {
-			return true
-		}
-		node = node.next
+// topoVisitor keeps vertices being visited in path, so the cycle can be
+// cut out of it once a back edge is met, and collects finished vertices
+type topoVisitor struct {
+	NopVisitor
+	path     []Vertex
+	finished []Vertex
+	cycle    []Vertex
+}
+
+func (t *topoVisitor) DiscoverVertex(v Vertex) WalkAction {
+	t.path = append(t.path, v)
+	return Continue
+}
+
+func (t *topoVisitor) FinishVertex(v Vertex) WalkAction {
+	t.path = t.path[:len(t.path)-1]
+	t.finished = append(t.finished, v)
+	return Continue
+}
+
+func (t *topoVisitor) BackEdge(from, to Vertex) WalkAction {
+	var i = len(t.path) - 1
+	for t.path[i] != to {
+		i--
 	}
-	visited.add(v)
-	visiting.remove(v)
-	return false
+	t.cycle = make([]Vertex, len(t.path)-i)
+	copy(t.cycle, t.path[i:])
+	return Stop
 }
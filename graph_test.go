@@ -1,7 +1,13 @@
 package graph
 
 import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDiGraph_Add_Remove(t *testing.T) {
@@ -208,3 +214,100 @@ func TestDiGraph_Cyclic(t *testing.T) {
 		t.Log(g.repr())
 	}
 }
+
+func TestDiGraph_FindCycle(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E"},
+		[][2]string{
+			{"A", "B"}, {"B", "C"}, {"C", "D"},
+			{"D", "B"}, {"D", "E"},
+		},
+	)
+	var cycle, ok = g.FindCycle()
+	if !ok {
+		t.Fatal("Expected cycle")
+	}
+	if ids := idsOf(cycle); ids != "B C D" {
+		t.Errorf("Unexpected cycle: %s", ids)
+	}
+	var _, err = g.Sorted()
+	if !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) || len(cycleErr.Cycle) != 3 {
+		t.Errorf("Expected CycleError, got: %v", err)
+	}
+	if err.Error() != "cyclic graph: B -> C -> D -> B" {
+		t.Errorf("Unexpected message: %s", err)
+	}
+
+	g.Disconnect(vs["D"], vs["B"])
+	if cycle, ok = g.FindCycle(); ok {
+		t.Errorf("Unexpected cycle: %s", idsOf(cycle))
+	}
+	g.Connect(vs["E"], vs["E"])
+	if cycle, ok = g.FindCycle(); !ok || idsOf(cycle) != "E" {
+		t.Errorf("Expected self loop, got: %s", idsOf(cycle))
+	}
+}
+
+// mockDiGraph builds DiGraph from edges given as "from->to" pairs
+func mockDiGraph(ids []string, edges [][2]string) (DiGraph, map[string]Vertex) {
+	var g = NewDiGraph()
+	var vs = make(map[string]Vertex)
+	for _, id := range ids {
+		vs[id] = &vertex{id: id}
+		g.Add(vs[id])
+	}
+	for _, e := range edges {
+		g.Connect(vs[e[0]], vs[e[1]])
+	}
+	return g, vs
+}
+
+func idsOf(vs []Vertex) string {
+	var ids = make([]string, len(vs))
+	for i, v := range vs {
+		ids[i] = v.Id()
+	}
+	return strings.Join(ids, " ")
+}
+
+func TestDiGraph_DFSContext(t *testing.T) {
+	var g = NewDiGraph()
+	var prev Vertex
+	for i := 0; i < 100; i++ {
+		var v = &vertex{id: strconv.Itoa(i)}
+		g.Add(v)
+		if prev != nil {
+			g.Connect(prev, v)
+		}
+		prev = v
+	}
+	var first = g.order()[0]
+	var before = runtime.NumGoroutine()
+	var ctx, cancel = context.WithCancel(context.Background())
+	var dfs = g.DFSContext(ctx, first)
+	var bfs = g.BFSContext(ctx, first)
+	if v := <-dfs; v != first {
+		t.Errorf("Unexpected vertex: %s", v.Id())
+	}
+	if v := <-bfs; v != first {
+		t.Errorf("Unexpected vertex: %s", v.Id())
+	}
+	cancel()
+	waitGoroutines(t, before)
+}
+
+// waitGoroutines fails the test if number of goroutines
+// does not drop to n in a second
+func waitGoroutines(t *testing.T, n int) {
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("Goroutines leaked: %d, want %d", runtime.NumGoroutine(), n)
+}
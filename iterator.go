@@ -0,0 +1,131 @@
+package graph
+
+import "container/list"
+
+// ListIterator walks LinkedList without spawning goroutines:
+//
+//	var it = ll.Iter()
+//	for it.Next() {
+//		use(it.Vertex())
+//	}
+type ListIterator struct {
+	current *node
+	next    *node
+}
+
+func (l *LinkedList) Iter() ListIterator {
+	return ListIterator{next: l.head}
+}
+
+// Next advances the iterator and reports whether there is a vertex
+func (it *ListIterator) Next() bool {
+	if it.next == nil {
+		it.current = nil
+		return false
+	}
+	it.current = it.next
+	it.next = it.next.next
+	return true
+}
+
+func (it *ListIterator) Vertex() Vertex {
+	if it.current == nil {
+		return nil
+	}
+	return it.current.val
+}
+
+// Traversal is a pull iterator over vertices of DiGraph
+// in DFS or BFS order, every vertex is yielded once
+type Traversal struct {
+	g        DiGraph
+	visited  set
+	pending  []Vertex
+	useQueue bool
+	current  Vertex
+}
+
+func (g DiGraph) DFSIter(v Vertex) *Traversal {
+	return g.newTraversal(v, false)
+}
+
+func (g DiGraph) BFSIter(v Vertex) *Traversal {
+	return g.newTraversal(v, true)
+}
+
+func (g DiGraph) newTraversal(v Vertex, useQueue bool) *Traversal {
+	var t = &Traversal{
+		g:        g,
+		visited:  newSet(),
+		useQueue: useQueue,
+	}
+	if g.Has(v) {
+		t.pending = append(t.pending, v)
+	}
+	return t
+}
+
+// Next advances the traversal and reports whether there is a vertex
+func (t *Traversal) Next() bool {
+	t.current = nil
+	for len(t.pending) > 0 {
+		var cv Vertex
+		if t.useQueue {
+			cv = t.pending[0]
+			t.pending[0] = nil
+			t.pending = t.pending[1:]
+		} else {
+			cv = t.pending[len(t.pending)-1]
+			t.pending = t.pending[:len(t.pending)-1]
+		}
+		if t.visited.contains(cv) {
+			continue
+		}
+		t.visited.add(cv)
+		for node := t.g[cv].head; node != nil; node = node.next {
+			if !t.visited.contains(node.val) {
+				t.pending = append(t.pending, node.val)
+			}
+		}
+		t.current = cv
+		return true
+	}
+	return false
+}
+
+func (t *Traversal) Vertex() Vertex {
+	return t.current
+}
+
+// EdgeIterator walks edges of UWGraph vertex
+type EdgeIterator struct {
+	current *list.Element
+	next    *list.Element
+}
+
+// EdgeIter returns iterator over edges of v,
+// it yields nothing if v is missing
+func (g *UWGraph) EdgeIter(v UVertex) EdgeIterator {
+	if !g.Has(v) {
+		return EdgeIterator{}
+	}
+	return EdgeIterator{next: g.graph[v.Id()].Edges().Front()}
+}
+
+// Next advances the iterator and reports whether there is an edge
+func (it *EdgeIterator) Next() bool {
+	if it.next == nil {
+		it.current = nil
+		return false
+	}
+	it.current = it.next
+	it.next = it.next.Next()
+	return true
+}
+
+func (it *EdgeIterator) Edge() Edge {
+	if it.current == nil {
+		return nil
+	}
+	return it.current.Value.(Edge)
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestLinkedList_Iter(t *testing.T) {
+	var ll = NewLinkedList()
+	var it = ll.Iter()
+	if it.Next() || it.Vertex() != nil {
+		t.Fatal("Empty list yielded a vertex")
+	}
+	for i := 0; i < 100; i++ {
+		ll.Append(&vertex{id: strconv.Itoa(i)})
+	}
+	var j int
+	it = ll.Iter()
+	for it.Next() {
+		if n, _ := strconv.Atoi(it.Vertex().Id()); n != j {
+			t.Errorf("Wrong vertex received, expected: %v, got: %v", j, n)
+		}
+		j++
+	}
+	if j != 100 || it.Vertex() != nil {
+		t.Errorf("Expected 100 vertices, got: %d", j)
+	}
+}
+
+func TestDiGraph_DFSIter_BFSIter(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D"},
+		[][2]string{
+			{"A", "C"}, {"A", "B"}, {"B", "C"}, {"C", "D"}, {"B", "D"},
+		},
+	)
+	var tests = []struct {
+		name string
+		it   *Traversal
+		want string
+	}{
+		{name: "dfs", it: g.DFSIter(vs["A"]), want: "A B D C"},
+		{name: "bfs", it: g.BFSIter(vs["A"]), want: "A C B D"},
+		{name: "missing", it: g.BFSIter(&vertex{"Q"}), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Vertex
+			for tt.it.Next() {
+				got = append(got, tt.it.Vertex())
+			}
+			if ids := idsOf(got); ids != tt.want {
+				t.Errorf("Traversal = %s, want %s", ids, tt.want)
+			}
+		})
+	}
+	var got []Vertex
+	for v := range g.DFS(vs["A"]) {
+		got = append(got, v)
+	}
+	if ids := idsOf(got); ids != "A B D C" {
+		t.Errorf("DFS() = %s, want A B D C", ids)
+	}
+}
+
+func TestUWGraph_EdgeIter(t *testing.T) {
+	var g = NewUWGraph()
+	for _, id := range []string{"A", "B", "C"} {
+		g.Add(newUV(id))
+	}
+	g.Connect(newUV("A"), newUV("B"), 1)
+	g.Connect(newUV("A"), newUV("C"), 2)
+	var it = g.EdgeIter(newUV("A"))
+	var ids string
+	var weight float64
+	for it.Next() {
+		ids += it.Edge().To().Id()
+		weight += it.Edge().Weight()
+	}
+	if ids != "BC" || weight != 3 {
+		t.Errorf("Unexpected edges: %s, weight: %v", ids, weight)
+	}
+	it = g.EdgeIter(newUV("Q"))
+	if it.Next() {
+		t.Error("Missing vertex yielded an edge")
+	}
+}
@@ -0,0 +1,123 @@
+package graph
+
+// LowestCommonAncestors returns common ancestors of a and b which are not
+// ancestors of other common ones, sorted by Id. A vertex counts as its own
+// ancestor, so if a reaches b the result is a. It is defined for DAG only
+func (g DiGraph) LowestCommonAncestors(a, b Vertex) ([]Vertex, error) {
+	if !g.Has(a) || !g.Has(b) {
+		return nil, ErrMissingVertex
+	}
+	if cycle, ok := g.FindCycle(); ok {
+		return nil, &CycleError{Cycle: cycle}
+	}
+	var reverse = g.Reverse()
+	var common = newSet()
+	var ancestors, _ = reverse.Descendants(a)
+	var ofA = newSet()
+	ofA.add(a)
+	for _, v := range ancestors {
+		ofA.add(v)
+	}
+	ancestors, _ = reverse.Descendants(b)
+	for _, v := range append(ancestors, b) {
+		if ofA.contains(v) {
+			common.add(v)
+		}
+	}
+	// a common ancestor having a descendant among common ones
+	// always has a direct successor among them
+	var res = make([]Vertex, 0)
+	for v := range common {
+		if !g.connectedToAny(v.(Vertex), common) {
+			res = append(res, v.(Vertex))
+		}
+	}
+	sortVertices(res)
+	return res, nil
+}
+
+func (g DiGraph) connectedToAny(v Vertex, vs set) bool {
+	for node := g[v].head; node != nil; node = node.next {
+		if vs.contains(node.val) {
+			return true
+		}
+	}
+	return false
+}
+
+// LCAIndex answers lowest common ancestors queries on a snapshot of DAG,
+// it keeps a bitset of ancestors for every vertex
+type LCAIndex struct {
+	*indexed
+	ancestors []bitset
+}
+
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) add(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+func (b bitset) contains(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+// NewLCAIndex preprocesses DAG in O(V*(V+E)/64) time and O(V*V/64) memory,
+// later changes of the graph are not reflected by the index
+func NewLCAIndex(g DiGraph) (*LCAIndex, error) {
+	var order, err = g.SortedFunc(ById)
+	if err != nil {
+		return nil, err
+	}
+	var l = &LCAIndex{
+		indexed:   g.indexed(),
+		ancestors: make([]bitset, len(g)),
+	}
+	for i := range l.ancestors {
+		l.ancestors[i] = newBitset(len(g))
+		l.ancestors[i].add(i)
+	}
+	for _, v := range order {
+		var i = l.index[v]
+		for _, j := range l.adj[i] {
+			for k, word := range l.ancestors[i] {
+				l.ancestors[j][k] |= word
+			}
+		}
+	}
+	return l, nil
+}
+
+// LowestCommonAncestors is DiGraph.LowestCommonAncestors using the index
+func (l *LCAIndex) LowestCommonAncestors(a, b Vertex) ([]Vertex, error) {
+	var i, okA = l.index[a]
+	var j, okB = l.index[b]
+	if !okA || !okB {
+		return nil, ErrMissingVertex
+	}
+	var common = newBitset(len(l.vertices))
+	for k := range common {
+		common[k] = l.ancestors[i][k] & l.ancestors[j][k]
+	}
+	var res = make([]Vertex, 0)
+	for v := range l.vertices {
+		if !common.contains(v) {
+			continue
+		}
+		var lowest = true
+		for _, w := range l.adj[v] {
+			if common.contains(w) {
+				lowest = false
+				break
+			}
+		}
+		if lowest {
+			res = append(res, l.vertices[v])
+		}
+	}
+	return res, nil
+}
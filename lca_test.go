@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiGraph_LowestCommonAncestors(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"R", "A", "B", "C", "D", "E", "F", "X"},
+		[][2]string{
+			{"R", "A"}, {"R", "B"}, {"A", "C"}, {"B", "C"},
+			{"A", "D"}, {"B", "D"}, {"C", "E"}, {"D", "F"},
+		},
+	)
+	var index, err = NewLCAIndex(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lca = map[string]func(a, b Vertex) ([]Vertex, error){
+		"graph": g.LowestCommonAncestors,
+		"index": index.LowestCommonAncestors,
+	}
+	var tests = []struct {
+		a    string
+		b    string
+		want string
+	}{
+		{a: "E", b: "F", want: "A B"},
+		{a: "C", b: "D", want: "A B"},
+		{a: "A", b: "E", want: "A"},
+		{a: "E", b: "E", want: "E"},
+		{a: "C", b: "A", want: "A"},
+		{a: "X", b: "A", want: ""},
+	}
+	for name, query := range lca {
+		for _, tt := range tests {
+			t.Run(name+" "+tt.a+"-"+tt.b, func(t *testing.T) {
+				var got, err = query(vs[tt.a], vs[tt.b])
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ids := idsOf(got); ids != tt.want {
+					t.Errorf("LowestCommonAncestors(%s, %s) = %s, want %s", tt.a, tt.b, ids, tt.want)
+				}
+			})
+		}
+		if _, err = query(vs["A"], &vertex{"Q"}); err != ErrMissingVertex {
+			t.Errorf("Expected ErrMissingVertex, got: %v", err)
+		}
+	}
+
+	g.Connect(vs["E"], vs["R"])
+	if _, err = g.LowestCommonAncestors(vs["A"], vs["B"]); !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+	if _, err = NewLCAIndex(g); !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+}
@@ -1,5 +1,7 @@
 package graph
 
+import "context"
+
 type node struct {
 	val  Vertex
 	next *node
@@ -59,14 +61,23 @@ func (l *LinkedList) Remove(v Vertex) bool {
 }
 
 func (l *LinkedList) Iterator() <-chan Vertex {
+	return l.IteratorContext(context.Background())
+}
+
+// IteratorContext is Iterator which stops once ctx is done, the channel
+// is closed then and the producing goroutine exits
+func (l *LinkedList) IteratorContext(ctx context.Context) <-chan Vertex {
 	var ch = make(chan Vertex, 10)
 	go func() {
 		defer close(ch)
-		var node = l.head
-		for node != nil {
-			ch <- node.val
-			node = node.next
+		var it = l.Iter()
+		for it.Next() {
+			select {
+			case ch <- it.Vertex():
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 	return ch
-}
\ No newline at end of file
+}
@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"context"
+	"runtime"
 	"strconv"
 	"testing"
 )
@@ -105,3 +107,17 @@ func TestLinkedList_Iterator(t *testing.T) {
 		j++
 	}
 }
+
+func TestLinkedList_IteratorContext(t *testing.T) {
+	var ll = NewLinkedList()
+	for i := 0; i < 100; i++ {
+		ll.Append(&vertex{id: strconv.Itoa(i)})
+	}
+	var before = runtime.NumGoroutine()
+	var ctx, cancel = context.WithCancel(context.Background())
+	if v := <-ll.IteratorContext(ctx); v.Id() != "0" {
+		t.Errorf("Wrong vertex received, expected: 0, got: %s", v.Id())
+	}
+	cancel()
+	waitGoroutines(t, before)
+}
@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"sync"
+)
+
+// visitedShards is the number of independently locked parts of the
+// visited set, it keeps workers from contending for a single lock
+const visitedShards = 256
+
+// visitedSet is a set of vertices safe for concurrent use
+type visitedSet [visitedShards]struct {
+	sync.Mutex
+	m map[Vertex]struct{}
+}
+
+func newVisitedSet() *visitedSet {
+	var s = &visitedSet{}
+	for i := range s {
+		s[i].m = make(map[Vertex]struct{})
+	}
+	return s
+}
+
+// add reports whether v was added, false means it had been visited
+func (s *visitedSet) add(v Vertex) bool {
+	var shard = &s[shardOf(v.Id())]
+	shard.Lock()
+	var _, ok = shard.m[v]
+	if !ok {
+		shard.m[v] = struct{}{}
+	}
+	shard.Unlock()
+	return !ok
+}
+
+// shardOf hashes id with FNV-1a
+func shardOf(id string) uint32 {
+	var h uint32 = 2166136261
+	for i := 0; i < len(id); i++ {
+		h ^= uint32(id[i])
+		h *= 16777619
+	}
+	return h % visitedShards
+}
+
+// discovery is a vertex found by a worker along with its parent
+type discovery struct {
+	vertex Vertex
+	parent Vertex
+}
+
+// ParallelBFS is BFSTree expanding every level with a pool of workers,
+// depths match BFSTree while parents and the order of vertices within
+// a level may differ between runs. The tree is filled in by a separate
+// goroutine while workers expand the next level
+func (g DiGraph) ParallelBFS(v Vertex, maxDepth, workers int) (*SearchTree, error) {
+	if !g.Has(v) {
+		return nil, ErrMissingVertex
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	var visited = newVisitedSet()
+	visited.add(v)
+	var t = newSearchTree(v)
+	var merging sync.WaitGroup
+	var level = []discovery{{vertex: v}}
+	for depth := 1; len(level) > 0 && depth-1 != maxDepth; depth++ {
+		var chunk = (len(level) + workers - 1) / workers
+		var found = make([][]discovery, workers)
+		var wg sync.WaitGroup
+		for w := 0; w*chunk < len(level); w++ {
+			var end = (w + 1) * chunk
+			if end > len(level) {
+				end = len(level)
+			}
+			wg.Add(1)
+			go func(w int, part []discovery) {
+				defer wg.Done()
+				for _, d := range part {
+					for node := g[d.vertex].head; node != nil; node = node.next {
+						if visited.add(node.val) {
+							found[w] = append(found[w], discovery{vertex: node.val, parent: d.vertex})
+						}
+					}
+				}
+			}(w, level[w*chunk:end])
+		}
+		wg.Wait()
+		var size int
+		for _, part := range found {
+			size += len(part)
+		}
+		level = make([]discovery, 0, size)
+		for _, part := range found {
+			level = append(level, part...)
+		}
+		merging.Wait()
+		merging.Add(1)
+		go func(depth int, level []discovery) {
+			defer merging.Done()
+			for _, d := range level {
+				t.depth[d.vertex] = depth
+				t.parent[d.vertex] = d.parent
+				t.order = append(t.order, d.vertex)
+			}
+		}(depth, level)
+	}
+	merging.Wait()
+	return t, nil
+}
@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"math/rand"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+func TestDiGraph_ParallelBFS(t *testing.T) {
+	var rnd = rand.New(rand.NewSource(1))
+	var g = NewDiGraph()
+	var vs = make([]Vertex, 1000)
+	for i := range vs {
+		vs[i] = &vertex{id: strconv.Itoa(i)}
+		g.Add(vs[i])
+	}
+	for i := 0; i < 3000; i++ {
+		g.Connect(vs[rnd.Intn(len(vs))], vs[rnd.Intn(len(vs))])
+	}
+	for _, maxDepth := range []int{-1, 2} {
+		var want, _ = g.BFSTree(vs[0], maxDepth)
+		var got, err = g.ParallelBFS(vs[0], maxDepth, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Vertices()) != len(want.Vertices()) {
+			t.Fatalf("Expected %d vertices, got: %d", len(want.Vertices()), len(got.Vertices()))
+		}
+		for _, v := range want.Vertices() {
+			var d, _ = want.Depth(v)
+			if gd, ok := got.Depth(v); !ok || gd != d {
+				t.Errorf("Depth(%s) = %d, want %d", v.Id(), gd, d)
+			}
+			if p, ok := got.Parent(v); ok {
+				if pd, _ := got.Depth(p); pd != d-1 {
+					t.Errorf("Parent of %s is at depth %d, want %d", v.Id(), pd, d-1)
+				}
+			}
+		}
+	}
+	if _, err := g.ParallelBFS(&vertex{"Q"}, -1, 4); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+func benchmarkGraph() (DiGraph, Vertex) {
+	var rnd = rand.New(rand.NewSource(1))
+	var g = NewDiGraph()
+	var vs = make([]Vertex, 200000)
+	for i := range vs {
+		vs[i] = &vertex{id: strconv.Itoa(i)}
+		g.Add(vs[i])
+	}
+	for i := 0; i < 4*len(vs); i++ {
+		g.Connect(vs[rnd.Intn(len(vs))], vs[rnd.Intn(len(vs))])
+	}
+	return g, vs[0]
+}
+
+func BenchmarkDiGraph_BFSTree(b *testing.B) {
+	var g, root = benchmarkGraph()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.BFSTree(root, -1)
+	}
+}
+
+func BenchmarkDiGraph_ParallelBFS(b *testing.B) {
+	var g, root = benchmarkGraph()
+	var workers = runtime.GOMAXPROCS(0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.ParallelBFS(root, -1, workers)
+	}
+}
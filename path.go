@@ -2,8 +2,11 @@ package graph
 
 import (
 	"container/list"
+	"errors"
 )
 
+var ErrNoPath = errors.New("no path between vertices")
+
 type Path struct {
 	weight   float64
 	vertices *list.List
@@ -0,0 +1,187 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+var ErrNegativeWeight = errors.New("negative edge weight")
+
+// RandomWalkOptions configure random walks
+type RandomWalkOptions struct {
+	// Length is the number of steps of a walk, a walk ends
+	// earlier if it gets to a vertex without edges, a walk
+	// of non-positive length consists of the start only
+	Length int
+	// Restart is the probability to jump back to the start
+	// instead of making a step
+	Restart float64
+	// Weighted makes UWGraph walks choose edges with probability
+	// proportional to their weights, DiGraph ignores it
+	Weighted bool
+	// Rand is the source of randomness, seed it to reproduce walks,
+	// a source seeded with the current time is used if nil
+	Rand *rand.Rand
+}
+
+func (o RandomWalkOptions) rand() *rand.Rand {
+	if o.Rand == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return o.Rand
+}
+
+// size returns the maximum number of vertices in a walk
+func (o RandomWalkOptions) size() int {
+	if o.Length < 0 {
+		return 1
+	}
+	return o.Length + 1
+}
+
+// RandomWalk returns vertices visited by a walk from start, where each step
+// follows an edge chosen uniformly at random. The walk includes start
+func (g DiGraph) RandomWalk(start Vertex, opts RandomWalkOptions) ([]Vertex, error) {
+	if !g.Has(start) {
+		return nil, ErrMissingVertex
+	}
+	return g.randomWalk(start, opts, opts.rand()), nil
+}
+
+func (g DiGraph) randomWalk(start Vertex, opts RandomWalkOptions, rnd *rand.Rand) []Vertex {
+	var walk = make([]Vertex, 1, opts.size())
+	walk[0] = start
+	var neighbours []Vertex
+	var current = start
+	for i := 0; i < opts.Length; i++ {
+		if opts.Restart > 0 && rnd.Float64() < opts.Restart {
+			current = start
+			walk = append(walk, current)
+			continue
+		}
+		neighbours = neighbours[:0]
+		for node := g[current].head; node != nil; node = node.next {
+			neighbours = append(neighbours, node.val)
+		}
+		if len(neighbours) == 0 {
+			break
+		}
+		current = neighbours[rnd.Intn(len(neighbours))]
+		walk = append(walk, current)
+	}
+	return walk
+}
+
+// SampleNeighbourhood runs the given number of random walks from start
+// and returns distinct vertices visited in the order of the first visit
+func (g DiGraph) SampleNeighbourhood(start Vertex, walks int, opts RandomWalkOptions) ([]Vertex, error) {
+	if !g.Has(start) {
+		return nil, ErrMissingVertex
+	}
+	var rnd = opts.rand()
+	var visited = newSet()
+	var res = make([]Vertex, 0)
+	for i := 0; i < walks; i++ {
+		for _, v := range g.randomWalk(start, opts, rnd) {
+			if !visited.contains(v) {
+				visited.add(v)
+				res = append(res, v)
+			}
+		}
+	}
+	return res, nil
+}
+
+// RandomWalk returns vertices visited by a walk from start, where each step
+// follows an edge chosen uniformly at random or proportionally to weights
+// if opts.Weighted is set. The walk includes start
+func (g *UWGraph) RandomWalk(start UVertex, opts RandomWalkOptions) ([]UVertex, error) {
+	if !g.Has(start) {
+		return nil, ErrMissingVertex
+	}
+	return g.randomWalk(g.graph[start.Id()], opts, opts.rand())
+}
+
+func (g *UWGraph) randomWalk(start UVertex, opts RandomWalkOptions, rnd *rand.Rand) ([]UVertex, error) {
+	var walk = make([]UVertex, 1, opts.size())
+	walk[0] = start
+	var current = start
+	for i := 0; i < opts.Length; i++ {
+		if opts.Restart > 0 && rnd.Float64() < opts.Restart {
+			current = start
+			walk = append(walk, current)
+			continue
+		}
+		var edge, err = chooseEdge(current, opts.Weighted, rnd)
+		if err != nil {
+			return nil, err
+		}
+		if edge == nil {
+			break
+		}
+		current = edge.To()
+		walk = append(walk, current)
+	}
+	return walk, nil
+}
+
+// chooseEdge picks a random edge of v, it falls back
+// to the uniform choice if all the weights are zero
+func chooseEdge(v UVertex, weighted bool, rnd *rand.Rand) (Edge, error) {
+	var edges = v.Edges()
+	if edges.Len() == 0 {
+		return nil, nil
+	}
+	var total float64
+	if weighted {
+		for e := edges.Front(); e != nil; e = e.Next() {
+			var w = e.Value.(Edge).Weight()
+			if w < 0 {
+				return nil, ErrNegativeWeight
+			}
+			total += w
+		}
+	}
+	if total == 0 {
+		var n = rnd.Intn(edges.Len())
+		var e = edges.Front()
+		for ; n > 0; n-- {
+			e = e.Next()
+		}
+		return e.Value.(Edge), nil
+	}
+	var r = rnd.Float64() * total
+	var e = edges.Front()
+	for ; e.Next() != nil; e = e.Next() {
+		r -= e.Value.(Edge).Weight()
+		if r < 0 {
+			break
+		}
+	}
+	return e.Value.(Edge), nil
+}
+
+// SampleNeighbourhood runs the given number of random walks from start
+// and returns distinct vertices visited in the order of the first visit
+func (g *UWGraph) SampleNeighbourhood(start UVertex, walks int, opts RandomWalkOptions) ([]UVertex, error) {
+	if !g.Has(start) {
+		return nil, ErrMissingVertex
+	}
+	var rnd = opts.rand()
+	var visited = newSet()
+	var res = make([]UVertex, 0)
+	for i := 0; i < walks; i++ {
+		var walk, err = g.randomWalk(g.graph[start.Id()], opts, rnd)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range walk {
+			if !visited.contains(v.Id()) {
+				visited.add(v.Id())
+				res = append(res, v)
+			}
+		}
+	}
+	return res, nil
+}
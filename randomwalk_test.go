@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDiGraph_RandomWalk(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E"},
+		[][2]string{
+			{"A", "B"}, {"A", "C"}, {"B", "C"}, {"C", "A"},
+			{"B", "A"}, {"C", "D"},
+		},
+	)
+	var walk = func(seed int64, restart float64) []Vertex {
+		var w, err = g.RandomWalk(vs["A"], RandomWalkOptions{
+			Length:  20,
+			Restart: restart,
+			Rand:    rand.New(rand.NewSource(seed)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+	var first = walk(7, 0)
+	if second := walk(7, 0); idsOf(first) != idsOf(second) {
+		t.Errorf("Walks with the same seed differ: %s, %s", idsOf(first), idsOf(second))
+	}
+	if first[0] != vs["A"] {
+		t.Errorf("Walk must begin with the start: %s", idsOf(first))
+	}
+	for i := 1; i < len(first); i++ {
+		var connected bool
+		for node := g[first[i-1]].head; node != nil; node = node.next {
+			connected = connected || node.val == first[i]
+		}
+		if !connected {
+			t.Fatalf("Walk does not follow edges: %s", idsOf(first))
+		}
+	}
+	if len(first) < 21 && first[len(first)-1] != vs["D"] {
+		t.Errorf("Walk ended early: %s", idsOf(first))
+	}
+	for _, v := range walk(3, 1) {
+		if v != vs["A"] {
+			t.Fatalf("Expected restarts only, got: %s", v.Id())
+		}
+	}
+
+	var sample, err = g.SampleNeighbourhood(vs["A"], 50, RandomWalkOptions{
+		Length: 3,
+		Rand:   rand.New(rand.NewSource(1)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortVertices(sample)
+	if ids := idsOf(sample); ids != "A B C D" {
+		t.Errorf("SampleNeighbourhood() = %s, want A B C D", ids)
+	}
+	if w, _ := g.RandomWalk(vs["A"], RandomWalkOptions{Length: -1}); idsOf(w) != "A" {
+		t.Errorf("Walk of negative length = %s, want A", idsOf(w))
+	}
+	if _, err = g.RandomWalk(&vertex{"Q"}, RandomWalkOptions{}); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+func TestUWGraph_RandomWalk(t *testing.T) {
+	var g = NewUWGraph()
+	for _, id := range []string{"A", "B", "C"} {
+		g.Add(newUV(id))
+	}
+	g.Connect(newUV("A"), newUV("B"), 1)
+	g.Connect(newUV("A"), newUV("C"), 0)
+	var walk, err = g.RandomWalk(newUV("A"), RandomWalkOptions{
+		Length:   10,
+		Weighted: true,
+		Rand:     rand.New(rand.NewSource(1)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(walk) != 11 {
+		t.Fatalf("Expected 11 vertices, got: %d", len(walk))
+	}
+	for i, v := range walk {
+		var want = "A"
+		if i%2 == 1 {
+			want = "B"
+		}
+		if v.Id() != want {
+			t.Errorf("Unexpected vertex at %d, expected: %s, got: %s", i, want, v.Id())
+		}
+	}
+
+	var sample, _ = g.SampleNeighbourhood(newUV("A"), 20, RandomWalkOptions{
+		Length: 2,
+		Rand:   rand.New(rand.NewSource(1)),
+	})
+	if len(sample) != 3 {
+		t.Errorf("Expected 3 sampled vertices, got: %d", len(sample))
+	}
+
+	walk, err = g.RandomWalk(newUV("A"), RandomWalkOptions{Length: -5})
+	if err != nil || len(walk) != 1 || walk[0].Id() != "A" {
+		t.Errorf("Expected walk of negative length to contain A only, got: %v, %v", walk, err)
+	}
+
+	g.Connect(newUV("B"), newUV("C"), -1)
+	_, err = g.RandomWalk(newUV("C"), RandomWalkOptions{Length: 1, Weighted: true})
+	if err != ErrNegativeWeight {
+		t.Errorf("Expected ErrNegativeWeight, got: %v", err)
+	}
+}
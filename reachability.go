@@ -0,0 +1,139 @@
+package graph
+
+// Reverse returns a graph with every edge turned around
+func (g DiGraph) Reverse() DiGraph {
+	var res = NewDiGraph()
+	for v := range g {
+		res.Add(v)
+	}
+	for v, ll := range g {
+		for node := ll.head; node != nil; node = node.next {
+			res[node.val].Append(v)
+		}
+	}
+	return res
+}
+
+// Descendants returns vertices other than v reachable from it in BFS order
+func (g DiGraph) Descendants(v Vertex) ([]Vertex, error) {
+	if !g.Has(v) {
+		return nil, ErrMissingVertex
+	}
+	var res = make([]Vertex, 0)
+	var visited = newSet()
+	visited.add(v)
+	var queue = []Vertex{v}
+	for len(queue) > 0 {
+		var cv = queue[0]
+		queue = queue[1:]
+		for node := g[cv].head; node != nil; node = node.next {
+			if !visited.contains(node.val) {
+				visited.add(node.val)
+				res = append(res, node.val)
+				queue = append(queue, node.val)
+			}
+		}
+	}
+	return res, nil
+}
+
+// Reachable reports whether there is a path from one vertex to another,
+// a vertex is always reachable from itself
+func (g DiGraph) Reachable(from, to Vertex) bool {
+	if !g.Has(from) || !g.Has(to) {
+		return false
+	}
+	if from == to {
+		return true
+	}
+	var visited = newSet()
+	visited.add(from)
+	var stack = []Vertex{from}
+	for len(stack) > 0 {
+		var cv = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for node := g[cv].head; node != nil; node = node.next {
+			if node.val == to {
+				return true
+			}
+			if !visited.contains(node.val) {
+				visited.add(node.val)
+				stack = append(stack, node.val)
+			}
+		}
+	}
+	return false
+}
+
+// IndexedDiGraph is DiGraph maintaining incoming edges of every vertex,
+// so upstream queries such as Ancestors cost as much as downstream ones.
+// The embedded DiGraph must not be modified directly
+type IndexedDiGraph struct {
+	DiGraph
+	in DiGraph
+}
+
+func NewIndexedDiGraph() *IndexedDiGraph {
+	return &IndexedDiGraph{
+		DiGraph: NewDiGraph(),
+		in:      NewDiGraph(),
+	}
+}
+
+// IndexDiGraph wraps g building its reverse index,
+// g must be modified through the result afterwards
+func IndexDiGraph(g DiGraph) *IndexedDiGraph {
+	return &IndexedDiGraph{
+		DiGraph: g,
+		in:      g.Reverse(),
+	}
+}
+
+// InEdges returns vertices connected to v
+func (g *IndexedDiGraph) InEdges(v Vertex) (*LinkedList, error) {
+	return g.in.Edges(v)
+}
+
+// Add adds v unless it is present, unlike DiGraph.Add it does not return
+// edges of v, they must be modified through Connect and Disconnect only
+func (g *IndexedDiGraph) Add(v Vertex) {
+	g.in.Add(v)
+	g.DiGraph.Add(v)
+}
+
+func (g *IndexedDiGraph) Remove(v Vertex) {
+	if !g.Has(v) {
+		return
+	}
+	for node := g.in[v].head; node != nil; node = node.next {
+		g.DiGraph[node.val].Remove(v)
+	}
+	for node := g.DiGraph[v].head; node != nil; node = node.next {
+		g.in[node.val].Remove(v)
+	}
+	delete(g.DiGraph, v)
+	delete(g.in, v)
+}
+
+func (g *IndexedDiGraph) Connect(from, to Vertex) error {
+	if err := g.DiGraph.Connect(from, to); err != nil {
+		return err
+	}
+	g.in[to].Append(from)
+	return nil
+}
+
+func (g *IndexedDiGraph) Disconnect(from, to Vertex) error {
+	if !g.Has(from) || !g.Has(to) {
+		return ErrMissingVertex
+	}
+	if g.DiGraph[from].Remove(to) {
+		g.in[to].Remove(from)
+	}
+	return nil
+}
+
+// Ancestors returns vertices other than v it is reachable from in BFS order
+func (g *IndexedDiGraph) Ancestors(v Vertex) ([]Vertex, error) {
+	return g.in.Descendants(v)
+}
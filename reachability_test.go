@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestDiGraph_Ancestors_Descendants(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E", "F"},
+		[][2]string{
+			{"A", "B"}, {"A", "C"}, {"B", "D"},
+			{"C", "D"}, {"D", "E"}, {"F", "C"},
+		},
+	)
+	var descendants, err = g.Descendants(vs["A"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := idsOf(descendants); ids != "B C D E" {
+		t.Errorf("Descendants(A) = %s, want B C D E", ids)
+	}
+	var ancestors, _ = IndexDiGraph(g).Ancestors(vs["D"])
+	sortVertices(ancestors)
+	if ids := idsOf(ancestors); ids != "A B C F" {
+		t.Errorf("Ancestors(D) = %s, want A B C F", ids)
+	}
+	if !g.Reachable(vs["F"], vs["E"]) || g.Reachable(vs["B"], vs["C"]) ||
+		!g.Reachable(vs["B"], vs["B"]) {
+		t.Error("Unexpected reachability")
+	}
+	if _, err = IndexDiGraph(g).Ancestors(&vertex{"Q"}); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+func TestIndexedDiGraph(t *testing.T) {
+	var g = NewIndexedDiGraph()
+	var vs = []Vertex{
+		&vertex{"A"},
+		&vertex{"B"},
+		&vertex{"C"},
+		&vertex{"D"},
+	}
+	for _, v := range vs {
+		g.Add(v)
+	}
+	g.Connect(vs[0], vs[1])
+	g.Connect(vs[1], vs[2])
+	g.Connect(vs[2], vs[3])
+	g.Connect(vs[0], vs[3])
+	var ancestors, err = g.Ancestors(vs[3])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortVertices(ancestors)
+	if ids := idsOf(ancestors); ids != "A B C" {
+		t.Errorf("Ancestors(D) = %s, want A B C", ids)
+	}
+
+	g.Disconnect(vs[1], vs[2])
+	ancestors, _ = g.Ancestors(vs[3])
+	sortVertices(ancestors)
+	if ids := idsOf(ancestors); ids != "A C" {
+		t.Errorf("Ancestors(D) = %s, want A C", ids)
+	}
+
+	g.Remove(vs[2])
+	var in, _ = g.InEdges(vs[3])
+	if in.head == nil || in.head != in.tail || in.head.val != vs[0] {
+		t.Errorf("Unexpected incoming edges of D")
+	}
+	if g.Reachable(vs[1], vs[3]) || !g.Reachable(vs[0], vs[3]) {
+		t.Error("Unexpected reachability")
+	}
+	var indexed = IndexDiGraph(g.DiGraph)
+	if ancestors, _ = indexed.Ancestors(vs[3]); idsOf(ancestors) != "A" {
+		t.Errorf("Ancestors(D) = %s, want A", idsOf(ancestors))
+	}
+	if t.Failed() {
+		t.Log(g.repr())
+	}
+}
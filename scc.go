@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Component is a strongly connected component of DiGraph,
+// it is used as a vertex of the condensation graph
+type Component struct {
+	id       string
+	vertices []Vertex
+}
+
+func newComponent(vertices []Vertex) *Component {
+	var ids = make([]string, len(vertices))
+	for i, v := range vertices {
+		ids[i] = v.Id()
+	}
+	return &Component{
+		id:       strings.Join(ids, ","),
+		vertices: vertices,
+	}
+}
+
+func (c *Component) Id() string {
+	return c.id
+}
+
+func (c *Component) Repr() string {
+	var buff = &bytes.Buffer{}
+	buff.WriteString("{ ")
+	for _, v := range c.vertices {
+		buff.WriteString(v.Repr())
+		buff.WriteString(" ")
+	}
+	buff.WriteString("}")
+	return buff.String()
+}
+
+func (c *Component) Equal(v Vertex) bool {
+	return c.id == v.Id()
+}
+
+// Vertices returns members of the component sorted by Id
+func (c *Component) Vertices() []Vertex {
+	return c.vertices
+}
+
+type tarjan struct {
+	g          DiGraph
+	counter    int
+	index      map[Vertex]int
+	low        map[Vertex]int
+	onStack    set
+	stack      []Vertex
+	components [][]Vertex
+}
+
+// StronglyConnectedComponents splits the graph into strongly connected
+// components using Tarjan's algorithm. Components are listed in topological
+// order of the condensation graph, vertices of a component are sorted by Id
+func (g DiGraph) StronglyConnectedComponents() [][]Vertex {
+	var t = &tarjan{
+		g:       g,
+		index:   make(map[Vertex]int),
+		low:     make(map[Vertex]int),
+		onStack: newSet(),
+	}
+	for _, v := range g.order() {
+		if _, ok := t.index[v]; !ok {
+			t.connect(v)
+		}
+	}
+	var n = len(t.components)
+	for i := 0; i < n/2; i++ {
+		t.components[i], t.components[n-1-i] = t.components[n-1-i], t.components[i]
+	}
+	return t.components
+}
+
+func (t *tarjan) connect(v Vertex) {
+	t.index[v] = t.counter
+	t.low[v] = t.counter
+	t.counter++
+	t.stack = append(t.stack, v)
+	t.onStack.add(v)
+	for node := t.g[v].head; node != nil; node = node.next {
+		var w = node.val
+		if _, ok := t.index[w]; !ok {
+			t.connect(w)
+			if t.low[w] < t.low[v] {
+				t.low[v] = t.low[w]
+			}
+		} else if t.onStack.contains(w) && t.index[w] < t.low[v] {
+			t.low[v] = t.index[w]
+		}
+	}
+	if t.low[v] != t.index[v] {
+		return
+	}
+	var component []Vertex
+	for {
+		var w = t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		t.onStack.remove(w)
+		component = append(component, w)
+		if w == v {
+			break
+		}
+	}
+	sortVertices(component)
+	t.components = append(t.components, component)
+}
+
+// Condensation returns a DAG whose vertices are the strongly connected
+// components (*Component) of the graph, a component is connected to another
+// one if any of its vertices is connected to a vertex of the other
+func (g DiGraph) Condensation() DiGraph {
+	var res = NewDiGraph()
+	var owner = make(map[Vertex]*Component)
+	var components []*Component
+	for _, vs := range g.StronglyConnectedComponents() {
+		var c = newComponent(vs)
+		res.Add(c)
+		components = append(components, c)
+		for _, v := range vs {
+			owner[v] = c
+		}
+	}
+	for _, from := range components {
+		var connected = newSet()
+		for _, v := range from.vertices {
+			for node := g[v].head; node != nil; node = node.next {
+				var to = owner[node.val]
+				if to == from || connected.contains(to) {
+					continue
+				}
+				connected.add(to)
+				res.Connect(from, to)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestDiGraph_StronglyConnectedComponents(t *testing.T) {
+	var g, _ = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E", "F", "G"},
+		[][2]string{
+			{"A", "B"}, {"B", "C"}, {"C", "A"},
+			{"C", "D"}, {"D", "E"}, {"E", "D"},
+			{"E", "F"}, {"G", "G"},
+		},
+	)
+	var components = g.StronglyConnectedComponents()
+	var expected = map[string]bool{
+		"A B C": true,
+		"D E":   true,
+		"F":     true,
+		"G":     true,
+	}
+	if len(components) != len(expected) {
+		t.Fatalf("Expected %d components, got: %d", len(expected), len(components))
+	}
+	var position = make(map[string]int)
+	for i, c := range components {
+		var ids = idsOf(c)
+		if !expected[ids] {
+			t.Errorf("Unexpected component: %s", ids)
+		}
+		position[ids] = i
+	}
+	if position["A B C"] > position["D E"] || position["D E"] > position["F"] {
+		t.Errorf("Components are not in topological order: %v", position)
+	}
+	if t.Failed() {
+		t.Log(g.repr())
+	}
+}
+
+func TestDiGraph_Condensation(t *testing.T) {
+	var g, _ = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E"},
+		[][2]string{
+			{"A", "B"}, {"B", "A"}, {"A", "C"}, {"B", "C"},
+			{"C", "D"}, {"D", "C"}, {"E", "A"},
+		},
+	)
+	var dag = g.Condensation()
+	if len(dag) != 3 {
+		t.Fatalf("Expected 3 components, got: %d\n%s", len(dag), dag.repr())
+	}
+	if dag.Cyclic() {
+		t.Fatalf("Condensation must be acyclic\n%s", dag.repr())
+	}
+	var ls, err = dag.Sorted()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected = []string{"E", "A,B", "C,D"}
+	for i, v := range ls {
+		if v.Id() != expected[i] {
+			t.Errorf("Unexpected vertex, expected: %s, got: %s", expected[i], v.Id())
+		}
+	}
+	var ab, _ = dag.Edges(ls[1])
+	if ab.head == nil || ab.head != ab.tail {
+		t.Errorf("Expected a single edge from %s\n%s", ls[1].Repr(), dag.repr())
+	}
+}
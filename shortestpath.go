@@ -0,0 +1,107 @@
+package graph
+
+// ShortestPath returns vertices of a path from one vertex
+// to another having the fewest edges, see ShortestPathBidirectional
+// for the search running from both ends
+func (g DiGraph) ShortestPath(from, to Vertex) ([]Vertex, error) {
+	if !g.Has(from) || !g.Has(to) {
+		return nil, ErrMissingVertex
+	}
+	var path = g.bfs(from, -1, to).PathTo(to)
+	if path == nil {
+		return nil, ErrNoPath
+	}
+	return path, nil
+}
+
+// ShortestPathBidirectional is ShortestPath running BFS from both ends
+// until they meet. It builds incoming edges of the whole graph on every
+// call first, IndexedDiGraph maintains them and searches without it
+func (g DiGraph) ShortestPathBidirectional(from, to Vertex) ([]Vertex, error) {
+	if !g.Has(from) || !g.Has(to) {
+		return nil, ErrMissingVertex
+	}
+	return bidirectional(g, g.Reverse(), from, to)
+}
+
+// ShortestPathBidirectional is ShortestPath running BFS from both ends
+// until they meet, it is faster on large sparse graphs
+func (g *IndexedDiGraph) ShortestPathBidirectional(from, to Vertex) ([]Vertex, error) {
+	if !g.Has(from) || !g.Has(to) {
+		return nil, ErrMissingVertex
+	}
+	return bidirectional(g.DiGraph, g.in, from, to)
+}
+
+// frontier is one side of bidirectional search
+type frontier struct {
+	g      DiGraph
+	parent map[Vertex]Vertex
+	depth  map[Vertex]int
+	level  []Vertex
+}
+
+func newFrontier(g DiGraph, v Vertex) *frontier {
+	return &frontier{
+		g:      g,
+		parent: make(map[Vertex]Vertex),
+		depth:  map[Vertex]int{v: 0},
+		level:  []Vertex{v},
+	}
+}
+
+// expand visits the next level, it returns the vertex met by the other
+// side having the shortest total distance, or nil
+func (f *frontier) expand(other *frontier) Vertex {
+	var next []Vertex
+	var meet Vertex
+	var best int
+	for _, cv := range f.level {
+		for node := f.g[cv].head; node != nil; node = node.next {
+			if _, ok := f.depth[node.val]; ok {
+				continue
+			}
+			f.depth[node.val] = f.depth[cv] + 1
+			f.parent[node.val] = cv
+			next = append(next, node.val)
+			if d, ok := other.depth[node.val]; ok && (meet == nil || f.depth[node.val]+d < best) {
+				meet = node.val
+				best = f.depth[node.val] + d
+			}
+		}
+	}
+	f.level = next
+	return meet
+}
+
+func bidirectional(out, in DiGraph, from, to Vertex) ([]Vertex, error) {
+	if from == to {
+		return []Vertex{from}, nil
+	}
+	var forward = newFrontier(out, from)
+	var backward = newFrontier(in, to)
+	var meet Vertex
+	for meet == nil && len(forward.level) > 0 && len(backward.level) > 0 {
+		if len(forward.level) <= len(backward.level) {
+			meet = forward.expand(backward)
+		} else {
+			meet = backward.expand(forward)
+		}
+	}
+	if meet == nil {
+		return nil, ErrNoPath
+	}
+	var path []Vertex
+	for v := meet; v != from; v = forward.parent[v] {
+		path = append(path, v)
+	}
+	path = append(path, from)
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	for v := meet; v != to; {
+		v = backward.parent[v]
+		path = append(path, v)
+	}
+	return path, nil
+}
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestDiGraph_ShortestPath(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D", "E", "F"},
+		[][2]string{
+			{"A", "B"}, {"B", "C"}, {"C", "D"}, {"D", "E"},
+			{"A", "F"}, {"F", "D"}, {"E", "A"},
+		},
+	)
+	var indexed = IndexDiGraph(g)
+	var search = map[string]func(from, to Vertex) ([]Vertex, error){
+		"bfs":           g.ShortestPath,
+		"bidirectional": g.ShortestPathBidirectional,
+		"indexed":       indexed.ShortestPathBidirectional,
+	}
+	var tests = []struct {
+		from string
+		to   string
+		want string
+		err  error
+	}{
+		{from: "A", to: "E", want: "A F D E"},
+		{from: "B", to: "F", want: "B C D E A F"},
+		{from: "C", to: "C", want: "C"},
+		{from: "A", to: "Q", err: ErrMissingVertex},
+	}
+	for name, shortest := range search {
+		for _, tt := range tests {
+			t.Run(name+" "+tt.from+"-"+tt.to, func(t *testing.T) {
+				var to = vs[tt.to]
+				if to == nil {
+					to = &vertex{tt.to}
+				}
+				var path, err = shortest(vs[tt.from], to)
+				if err != tt.err {
+					t.Fatalf("Expected %v, got: %v", tt.err, err)
+				}
+				if ids := idsOf(path); ids != tt.want {
+					t.Errorf("Path = %s, want %s", ids, tt.want)
+				}
+			})
+		}
+	}
+	indexed.Disconnect(vs["E"], vs["A"])
+	if _, err := indexed.ShortestPathBidirectional(vs["E"], vs["A"]); err != ErrNoPath {
+		t.Errorf("Expected ErrNoPath, got: %v", err)
+	}
+	if _, err := g.ShortestPathBidirectional(vs["E"], vs["A"]); err != ErrNoPath {
+		t.Errorf("Expected ErrNoPath, got: %v", err)
+	}
+	if _, err := g.ShortestPath(vs["E"], vs["A"]); err != ErrNoPath {
+		t.Errorf("Expected ErrNoPath, got: %v", err)
+	}
+}
+
+func TestIndexedDiGraph_ShortestPathBidirectional(t *testing.T) {
+	var rnd = rand.New(rand.NewSource(1))
+	var g = NewDiGraph()
+	var vs = make([]Vertex, 200)
+	for i := range vs {
+		vs[i] = &vertex{id: strconv.Itoa(i)}
+		g.Add(vs[i])
+	}
+	for i := 0; i < 500; i++ {
+		g.Connect(vs[rnd.Intn(len(vs))], vs[rnd.Intn(len(vs))])
+	}
+	var indexed = IndexDiGraph(g)
+	for i := 0; i < 200; i++ {
+		var from, to = vs[rnd.Intn(len(vs))], vs[rnd.Intn(len(vs))]
+		var want, wantErr = g.ShortestPath(from, to)
+		var got, err = indexed.ShortestPathBidirectional(from, to)
+		if err != wantErr || len(got) != len(want) {
+			t.Fatalf("Path %s-%s = %s (%v), want %s (%v)",
+				from.Id(), to.Id(), idsOf(got), err, idsOf(want), wantErr)
+		}
+		if plain, _ := g.ShortestPathBidirectional(from, to); len(plain) != len(want) {
+			t.Fatalf("Path %s-%s = %s, want %s", from.Id(), to.Id(), idsOf(plain), idsOf(want))
+		}
+		for j := 1; j < len(got); j++ {
+			var connected bool
+			for node := g[got[j-1]].head; node != nil; node = node.next {
+				connected = connected || node.val == got[j]
+			}
+			if !connected {
+				t.Fatalf("Broken path: %s", idsOf(got))
+			}
+		}
+	}
+}
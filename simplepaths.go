@@ -0,0 +1,98 @@
+package graph
+
+import "container/list"
+
+// AllSimplePaths enumerates paths without repeated vertices from one vertex
+// to another in DFS order. Every path is passed to yield as soon as it is
+// found, enumeration stops once yield returns false or limits are reached
+func (g DiGraph) AllSimplePaths(from, to Vertex, limits Limits, yield func(path []Vertex) bool) error {
+	if !g.Has(from) || !g.Has(to) {
+		return ErrMissingVertex
+	}
+	var count int
+	var onPath = newSet()
+	var path []Vertex
+	var visit func(v Vertex) bool
+	visit = func(v Vertex) bool {
+		path = append(path, v)
+		defer func() {
+			path = path[:len(path)-1]
+		}()
+		if v == to {
+			var res = make([]Vertex, len(path))
+			copy(res, path)
+			count++
+			return !yield(res) || count == limits.MaxCount
+		}
+		if limits.MaxLength > 0 && len(path) > limits.MaxLength {
+			return false
+		}
+		onPath.add(v)
+		defer onPath.remove(v)
+		var followed = newSet()
+		for node := g[v].head; node != nil; node = node.next {
+			if onPath.contains(node.val) || followed.contains(node.val) {
+				continue
+			}
+			followed.add(node.val)
+			if visit(node.val) {
+				return true
+			}
+		}
+		return false
+	}
+	visit(from)
+	return nil
+}
+
+// AllSimplePaths enumerates paths without repeated vertices from one vertex
+// to another in DFS order. Every path is passed to yield as soon as it is
+// found, enumeration stops once yield returns false or limits are reached
+func (g *UWGraph) AllSimplePaths(from, to UVertex, limits Limits, yield func(path *Path) bool) error {
+	if !g.HasBoth(from, to) {
+		return ErrMissingVertex
+	}
+	var count int
+	var onPath = newSet()
+	var edges []Edge
+	var visit func(v UVertex) bool
+	visit = func(v UVertex) bool {
+		if v.Equal(to) {
+			count++
+			return !yield(newEdgePath(g.graph[from.Id()], edges)) || count == limits.MaxCount
+		}
+		if limits.MaxLength > 0 && len(edges) == limits.MaxLength {
+			return false
+		}
+		onPath.add(v.Id())
+		defer onPath.remove(v.Id())
+		for e := v.Edges().Front(); e != nil; e = e.Next() {
+			var edge = e.Value.(Edge)
+			if onPath.contains(edge.To().Id()) {
+				continue
+			}
+			edges = append(edges, edge)
+			var stop = visit(edge.To())
+			edges = edges[:len(edges)-1]
+			if stop {
+				return true
+			}
+		}
+		return false
+	}
+	visit(g.graph[from.Id()])
+	return nil
+}
+
+// newEdgePath builds Path going from a vertex along edges
+func newEdgePath(from UVertex, edges []Edge) *Path {
+	var path = &Path{
+		vertices: list.New(),
+	}
+	path.vertices.PushBack(from)
+	for _, e := range edges {
+		path.weight += e.Weight()
+		path.vertices.PushBack(e.To())
+	}
+	return path
+}
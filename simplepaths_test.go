@@ -0,0 +1,112 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestDiGraph_AllSimplePaths(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D"},
+		[][2]string{
+			{"A", "B"}, {"A", "C"}, {"B", "C"}, {"C", "B"},
+			{"B", "D"}, {"C", "D"}, {"D", "A"}, {"A", "D"},
+		},
+	)
+	var tests = []struct {
+		name   string
+		limits Limits
+		want   []string
+	}{
+		{
+			name: "all",
+			want: []string{"A B C D", "A B D", "A C B D", "A C D", "A D"},
+		},
+		{
+			name:   "length",
+			limits: Limits{MaxLength: 2},
+			want:   []string{"A B D", "A C D", "A D"},
+		},
+		{
+			name:   "count",
+			limits: Limits{MaxCount: 2},
+			want:   []string{"A B C D", "A B D"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			var err = g.AllSimplePaths(vs["A"], vs["D"], tt.limits, func(path []Vertex) bool {
+				got = append(got, idsOf(path))
+				return true
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("AllSimplePaths() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("AllSimplePaths() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+	var count int
+	g.AllSimplePaths(vs["A"], vs["D"], Limits{}, func(path []Vertex) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("Enumeration has not been stopped, got %d paths", count)
+	}
+	if err := g.AllSimplePaths(vs["A"], &vertex{"Q"}, Limits{}, nil); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+func TestUWGraph_AllSimplePaths(t *testing.T) {
+	var g = NewUWGraph()
+	for _, id := range []string{"A", "B", "C", "D"} {
+		g.Add(newUV(id))
+	}
+	g.Connect(newUV("A"), newUV("B"), 1)
+	g.Connect(newUV("B"), newUV("D"), 1)
+	g.Connect(newUV("A"), newUV("C"), 2)
+	g.Connect(newUV("C"), newUV("D"), 2)
+	g.Connect(newUV("B"), newUV("C"), 5)
+	var want = map[string]float64{
+		"A B D":   2,
+		"A B C D": 8,
+		"A C D":   4,
+		"A C B D": 8,
+	}
+	var got = make(map[string]float64)
+	var err = g.AllSimplePaths(newUV("A"), newUV("D"), Limits{}, func(path *Path) bool {
+		var ids []Vertex
+		for e := path.Vertices().Front(); e != nil; e = e.Next() {
+			ids = append(ids, &vertex{e.Value.(UVertex).Id()})
+		}
+		got[idsOf(ids)] = path.Weight()
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("AllSimplePaths() = %v, want %v", got, want)
+	}
+	for ids, w := range want {
+		if got[ids] != w {
+			t.Errorf("Weight of %s = %v, want %v", ids, got[ids], w)
+		}
+	}
+	var count int
+	g.AllSimplePaths(newUV("A"), newUV("D"), Limits{MaxLength: 2}, func(path *Path) bool {
+		count++
+		return true
+	})
+	if count != 2 {
+		t.Errorf("Expected 2 paths of at most 2 edges, got: %d", count)
+	}
+}
@@ -0,0 +1,118 @@
+package graph
+
+import "container/heap"
+
+// inDegrees counts incoming edges of every vertex
+func (g DiGraph) inDegrees() map[Vertex]int {
+	var degrees = make(map[Vertex]int, len(g))
+	for v, ll := range g {
+		if _, ok := degrees[v]; !ok {
+			degrees[v] = 0
+		}
+		for node := ll.head; node != nil; node = node.next {
+			degrees[node.val]++
+		}
+	}
+	return degrees
+}
+
+// SortedLayers groups vertices of DAG into layers, a vertex depends only
+// on vertices of earlier layers, so vertices of one layer can be processed
+// concurrently. Vertices of a layer are sorted by Id
+func (g DiGraph) SortedLayers() ([][]Vertex, error) {
+	if cycle, ok := g.FindCycle(); ok {
+		return make([][]Vertex, 0), &CycleError{Cycle: cycle}
+	}
+	var degrees = g.inDegrees()
+	var layer []Vertex
+	for _, v := range g.order() {
+		if degrees[v] == 0 {
+			layer = append(layer, v)
+		}
+	}
+	var res = make([][]Vertex, 0)
+	for len(layer) > 0 {
+		res = append(res, layer)
+		var next []Vertex
+		for _, v := range layer {
+			for node := g[v].head; node != nil; node = node.next {
+				degrees[node.val]--
+				if degrees[node.val] == 0 {
+					next = append(next, node.val)
+				}
+			}
+		}
+		sortVertices(next)
+		layer = next
+	}
+	return res, nil
+}
+
+// ById orders vertices by Id, it can be passed to SortedFunc
+func ById(a, b Vertex) bool {
+	return a.Id() < b.Id()
+}
+
+// SortedFunc implements topological sorting on DAG using Kahn's algorithm,
+// out of vertices ready to be emitted the least one according to less goes
+// first, ties are broken by Id, so identical graphs are always sorted the same
+func (g DiGraph) SortedFunc(less func(a, b Vertex) bool) ([]Vertex, error) {
+	if cycle, ok := g.FindCycle(); ok {
+		return make([]Vertex, 0), &CycleError{Cycle: cycle}
+	}
+	var degrees = g.inDegrees()
+	var ready = &vertexHeap{less: func(a, b Vertex) bool {
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return a.Id() < b.Id()
+	}}
+	for v, degree := range degrees {
+		if degree == 0 {
+			heap.Push(ready, v)
+		}
+	}
+	var res = make([]Vertex, 0, len(g))
+	for ready.Len() > 0 {
+		var v = heap.Pop(ready).(Vertex)
+		res = append(res, v)
+		for node := g[v].head; node != nil; node = node.next {
+			degrees[node.val]--
+			if degrees[node.val] == 0 {
+				heap.Push(ready, node.val)
+			}
+		}
+	}
+	return res, nil
+}
+
+// vertexHeap implements heap.Interface
+type vertexHeap struct {
+	vertices []Vertex
+	less     func(a, b Vertex) bool
+}
+
+func (h *vertexHeap) Len() int {
+	return len(h.vertices)
+}
+
+func (h *vertexHeap) Less(i, j int) bool {
+	return h.less(h.vertices[i], h.vertices[j])
+}
+
+func (h *vertexHeap) Swap(i, j int) {
+	h.vertices[i], h.vertices[j] = h.vertices[j], h.vertices[i]
+}
+
+func (h *vertexHeap) Push(v interface{}) {
+	h.vertices = append(h.vertices, v.(Vertex))
+}
+
+func (h *vertexHeap) Pop() interface{} {
+	var last = h.vertices[len(h.vertices)-1]
+	h.vertices = h.vertices[:len(h.vertices)-1]
+	return last
+}
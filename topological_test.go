@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiGraph_SortedLayers(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"P", "A", "B", "X", "Y", "W"},
+		[][2]string{
+			{"X", "A"}, {"X", "B"}, {"A", "P"}, {"B", "P"},
+			{"X", "Y"}, {"Y", "P"}, {"W", "A"}, {"X", "A"},
+		},
+	)
+	var layers, err = g.SortedLayers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected = []string{"W X", "A B Y", "P"}
+	if len(layers) != len(expected) {
+		t.Fatalf("Expected %d layers, got: %d", len(expected), len(layers))
+	}
+	for i, layer := range layers {
+		if ids := idsOf(layer); ids != expected[i] {
+			t.Errorf("Unexpected layer %d, expected: %s, got: %s", i, expected[i], ids)
+		}
+	}
+
+	g.Connect(vs["P"], vs["W"])
+	if layers, err = g.SortedLayers(); !errors.Is(err, ErrCyclicGraph) || len(layers) != 0 {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+}
+
+func TestDiGraph_SortedFunc(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"P", "A", "B", "X", "Y", "W"},
+		[][2]string{
+			{"X", "A"}, {"X", "B"}, {"A", "P"}, {"B", "P"},
+			{"X", "Y"}, {"Y", "P"}, {"W", "A"},
+		},
+	)
+	var priority = map[string]int{"Y": -1, "B": -1}
+	var tests = []struct {
+		name string
+		less func(a, b Vertex) bool
+		want string
+	}{
+		{
+			name: "by id",
+			less: ById,
+			want: "W X A B Y P",
+		},
+		{
+			name: "by priority",
+			less: func(a, b Vertex) bool {
+				return priority[a.Id()] < priority[b.Id()]
+			},
+			want: "W X B Y A P",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				var ls, err = g.SortedFunc(tt.less)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ids := idsOf(ls); ids != tt.want {
+					t.Fatalf("SortedFunc() = %s, want %s", ids, tt.want)
+				}
+			}
+		})
+	}
+
+	g.Connect(vs["P"], vs["X"])
+	if _, err := g.SortedFunc(ById); !errors.Is(err, ErrCyclicGraph) {
+		t.Errorf("Expected ErrCyclicGraph, got: %v", err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"container/list"
 	"github.com/emirpasic/gods/trees/binaryheap"
-	"math"
 	"strconv"
 )
 
@@ -175,45 +174,15 @@ type row struct {
 	weight   float64
 }
 
+// Path returns the lightest path between vertices using Dijkstra's algorithm,
+// weights must not be negative, it fails with ErrNegativeWeight otherwise
 func (g *UWGraph) Path(from, to UVertex) (*Path, error) {
 	if !g.HasBoth(from, to) {
 		return nil, ErrMissingVertex
 	}
-	var unvisited = newSet()
-	var table = make(map[string]*row)
-	for id := range g.graph {
-		unvisited.add(id)
-		table[id] = &row{
-			previous: nil,
-			weight:   math.Inf(1),
-		}
-	}
-	table[from.Id()].weight = 0
-	table[from.Id()].previous = from
-
-	var queue = list.New() // queue of Vertices
-	queue.PushBack(g.graph[from.Id()])
-	for queue.Len() > 0 {
-		var el = queue.Front()
-		var node = el.Value.(UVertex)
-		if !unvisited.contains(node.Id()) {
-			queue.Remove(el)
-			continue
-		}
-		for e := node.Edges().Front(); e != nil; e = e.Next() {
-			var edge = e.Value.(Edge)
-			var rec = table[edge.To().Id()]
-			var dis = table[node.Id()].weight + edge.Weight()
-			if rec.weight > dis {
-				rec.weight = dis
-				rec.previous = node
-			}
-			if unvisited.contains(edge.To().Id()) {
-				queue.PushBack(edge.To())
-			}
-		}
-		unvisited.remove(node.Id())
-		queue.Remove(el)
+	var table, err = (&search{graph: g.graph}).run(g.graph[from.Id()], g.graph[to.Id()])
+	if err != nil {
+		return nil, err
 	}
 	return newPath(table, g.graph[to.Id()]), nil
 }
@@ -258,6 +258,7 @@ func TestUWGraph_Path(t *testing.T) {
 		newUV("C"),
 		newUV("D"),
 		newUV("E"),
+		newUV("F"),
 	}
 	for _, v := range vertices {
 		g.Add(v)
@@ -304,6 +305,15 @@ func TestUWGraph_Path(t *testing.T) {
 			want:    0,
 			wantErr: false,
 		},
+		{
+			name: "A-F",
+			args: args{
+				from: newUV("A"),
+				to:   newUV("F"),
+			},
+			want:    0,
+			wantErr: true,
+		},
 		{
 			name: "D-B",
 			args: args{
@@ -326,6 +336,28 @@ func TestUWGraph_Path(t *testing.T) {
 			}
 		})
 	}
+	var path, _ = g.Path(newUV("A"), newUV("C"))
+	var ids string
+	for e := path.Vertices().Front(); e != nil; e = e.Next() {
+		ids += e.Value.(UVertex).Id()
+	}
+	if ids != "ADEC" {
+		t.Errorf("Unexpected path: %s", ids)
+	}
+	if _, err := g.Path(newUV("A"), newUV("F")); err != ErrNoPath {
+		t.Errorf("Expected ErrNoPath, got: %v", err)
+	}
+
+	g.Connect(newUV("A"), newUV("F"), -1)
+	if _, err := g.Path(newUV("A"), newUV("C")); err != ErrNegativeWeight {
+		t.Errorf("Expected ErrNegativeWeight, got: %v", err)
+	}
+	if _, err := g.AStar(newUV("A"), newUV("C"), Euclidean); err != ErrNegativeWeight {
+		t.Errorf("Expected ErrNegativeWeight, got: %v", err)
+	}
+	if _, err := g.KShortestPaths(newUV("A"), newUV("C"), 3); err != ErrNegativeWeight {
+		t.Errorf("Expected ErrNegativeWeight, got: %v", err)
+	}
 }
 
 func TestUWGraph_Cyclic(t *testing.T) {
@@ -0,0 +1,143 @@
+package graph
+
+import "context"
+
+// Step tells how a traversal of UWGraph has reached a vertex,
+// Edge is nil for the start and Weight is the sum of weights
+// of edges followed from the start
+type Step struct {
+	Vertex UVertex
+	Edge   Edge
+	Weight float64
+}
+
+// UTraversal is a pull iterator over vertices of UWGraph
+// in DFS or BFS order, every vertex is yielded once
+type UTraversal struct {
+	visited  set
+	pending  []Step
+	useQueue bool
+	current  Step
+}
+
+func (g *UWGraph) DFSIter(v UVertex) *UTraversal {
+	return g.newTraversal(v, false)
+}
+
+func (g *UWGraph) BFSIter(v UVertex) *UTraversal {
+	return g.newTraversal(v, true)
+}
+
+func (g *UWGraph) newTraversal(v UVertex, useQueue bool) *UTraversal {
+	var t = &UTraversal{
+		visited:  newSet(),
+		useQueue: useQueue,
+	}
+	if g.Has(v) {
+		t.pending = append(t.pending, Step{Vertex: g.graph[v.Id()]})
+	}
+	return t
+}
+
+// Next advances the traversal and reports whether there is a vertex
+func (t *UTraversal) Next() bool {
+	t.current = Step{}
+	for len(t.pending) > 0 {
+		var step Step
+		if t.useQueue {
+			step = t.pending[0]
+			t.pending[0] = Step{}
+			t.pending = t.pending[1:]
+		} else {
+			step = t.pending[len(t.pending)-1]
+			t.pending = t.pending[:len(t.pending)-1]
+		}
+		if t.visited.contains(step.Vertex.Id()) {
+			continue
+		}
+		t.visited.add(step.Vertex.Id())
+		for e := step.Vertex.Edges().Front(); e != nil; e = e.Next() {
+			var edge = e.Value.(Edge)
+			if !t.visited.contains(edge.To().Id()) {
+				t.pending = append(t.pending, Step{
+					Vertex: edge.To(),
+					Edge:   edge,
+					Weight: step.Weight + edge.Weight(),
+				})
+			}
+		}
+		t.current = step
+		return true
+	}
+	return false
+}
+
+func (t *UTraversal) Vertex() UVertex {
+	return t.current.Vertex
+}
+
+func (t *UTraversal) Step() Step {
+	return t.current
+}
+
+func (g *UWGraph) DFS(v UVertex) <-chan UVertex {
+	return g.DFSContext(context.Background(), v)
+}
+
+func (g *UWGraph) BFS(v UVertex) <-chan UVertex {
+	return g.BFSContext(context.Background(), v)
+}
+
+// DFSContext is DFS which stops once ctx is done, the channel is closed
+// then and the producing goroutine exits even if nobody reads it anymore
+func (g *UWGraph) DFSContext(ctx context.Context, v UVertex) <-chan UVertex {
+	var out = make(chan UVertex, 10)
+	go g.traverse(ctx, v, out, false)
+	return out
+}
+
+// BFSContext is BFS which stops once ctx is done, the channel is closed
+// then and the producing goroutine exits even if nobody reads it anymore
+func (g *UWGraph) BFSContext(ctx context.Context, v UVertex) <-chan UVertex {
+	var out = make(chan UVertex, 10)
+	go g.traverse(ctx, v, out, true)
+	return out
+}
+
+func (g *UWGraph) traverse(ctx context.Context, v UVertex, out chan<- UVertex, useQueue bool) {
+	defer close(out)
+	var it = g.newTraversal(v, useQueue)
+	for it.Next() {
+		select {
+		case out <- it.Vertex():
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// DFSSteps is DFSContext reporting the edge used to reach every vertex
+func (g *UWGraph) DFSSteps(ctx context.Context, v UVertex) <-chan Step {
+	var out = make(chan Step, 10)
+	go g.traverseSteps(ctx, v, out, false)
+	return out
+}
+
+// BFSSteps is BFSContext reporting the edge used to reach every vertex
+func (g *UWGraph) BFSSteps(ctx context.Context, v UVertex) <-chan Step {
+	var out = make(chan Step, 10)
+	go g.traverseSteps(ctx, v, out, true)
+	return out
+}
+
+func (g *UWGraph) traverseSteps(ctx context.Context, v UVertex, out chan<- Step, useQueue bool) {
+	defer close(out)
+	var it = g.newTraversal(v, useQueue)
+	for it.Next() {
+		select {
+		case out <- it.Step():
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"context"
+	"runtime"
+	"testing"
+)
+
+func mockUWGraph() *UWGraph {
+	var g = NewUWGraph()
+	for _, id := range []string{"K", "A", "B", "C", "D", "W"} {
+		g.Add(newUV(id))
+	}
+	g.Connect(newUV("K"), newUV("A"), 1)
+	g.Connect(newUV("K"), newUV("B"), 2)
+	g.Connect(newUV("A"), newUV("C"), 3)
+	g.Connect(newUV("B"), newUV("C"), 4)
+	g.Connect(newUV("C"), newUV("D"), 5)
+	return g
+}
+
+func TestUWGraph_DFS_BFS(t *testing.T) {
+	var g = mockUWGraph()
+	var tests = []struct {
+		name string
+		ch   <-chan UVertex
+		want string
+	}{
+		{name: "dfs", ch: g.DFS(newUV("K")), want: "K B C D A"},
+		{name: "bfs", ch: g.BFS(newUV("K")), want: "K A B C D"},
+		{name: "missing", ch: g.BFS(newUV("Q")), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Vertex
+			for v := range tt.ch {
+				got = append(got, &vertex{v.Id()})
+			}
+			if ids := idsOf(got); ids != tt.want {
+				t.Errorf("Traversal = %s, want %s", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestUWGraph_BFSSteps(t *testing.T) {
+	var g = mockUWGraph()
+	var want = map[string]struct {
+		from   string
+		weight float64
+	}{
+		"K": {from: "", weight: 0},
+		"A": {from: "K", weight: 1},
+		"B": {from: "K", weight: 2},
+		"C": {from: "A", weight: 4},
+		"D": {from: "C", weight: 9},
+	}
+	var count int
+	for step := range g.BFSSteps(context.Background(), newUV("K")) {
+		count++
+		var w = want[step.Vertex.Id()]
+		var from string
+		if step.Edge != nil {
+			from = step.Edge.From().Id()
+		}
+		if from != w.from || step.Weight != w.weight {
+			t.Errorf("Step to %s = %s:%v, want %s:%v", step.Vertex.Id(), from, step.Weight, w.from, w.weight)
+		}
+	}
+	if count != len(want) {
+		t.Errorf("Expected %d steps, got: %d", len(want), count)
+	}
+
+	var before = runtime.NumGoroutine()
+	var ctx, cancel = context.WithCancel(context.Background())
+	<-g.DFSSteps(ctx, newUV("K"))
+	<-g.DFSContext(ctx, newUV("K"))
+	cancel()
+	waitGoroutines(t, before)
+}
@@ -0,0 +1,207 @@
+package graph
+
+import "sort"
+
+// WalkAction tells Walk how to proceed after a visitor callback
+type WalkAction int
+
+const (
+	Continue WalkAction = iota
+	// SkipChildren returned from DiscoverVertex skips edges of the vertex,
+	// returned from TreeEdge leaves the target vertex undiscovered,
+	// other callbacks treat it as Continue
+	SkipChildren
+	// Stop ends the walk
+	Stop
+)
+
+// Visitor receives events of DFS run by DiGraph.Walk
+type Visitor interface {
+	DiscoverVertex(v Vertex) WalkAction
+	FinishVertex(v Vertex) WalkAction
+	// TreeEdge leads to an undiscovered vertex
+	TreeEdge(from, to Vertex) WalkAction
+	// BackEdge leads to a vertex being visited, it closes a cycle
+	BackEdge(from, to Vertex) WalkAction
+	// ForwardOrCrossEdge leads to a finished vertex
+	ForwardOrCrossEdge(from, to Vertex) WalkAction
+}
+
+// NopVisitor implements Visitor doing nothing,
+// embed it to implement only the callbacks needed
+type NopVisitor struct{}
+
+func (NopVisitor) DiscoverVertex(v Vertex) WalkAction {
+	return Continue
+}
+
+func (NopVisitor) FinishVertex(v Vertex) WalkAction {
+	return Continue
+}
+
+func (NopVisitor) TreeEdge(from, to Vertex) WalkAction {
+	return Continue
+}
+
+func (NopVisitor) BackEdge(from, to Vertex) WalkAction {
+	return Continue
+}
+
+func (NopVisitor) ForwardOrCrossEdge(from, to Vertex) WalkAction {
+	return Continue
+}
+
+const (
+	white = iota
+	grey
+	black
+)
+
+// Walk runs DFS from every root in turn reporting events to visitor,
+// vertices discovered from one root are not visited again from the next
+// ones. All vertices are used as roots in the order of Id if none given
+func (g DiGraph) Walk(visitor Visitor, roots ...Vertex) error {
+	for _, v := range roots {
+		if !g.Has(v) {
+			return ErrMissingVertex
+		}
+	}
+	if len(roots) == 0 {
+		roots = g.order()
+	}
+	var colors = make(map[Vertex]int, len(g))
+	for _, v := range roots {
+		if colors[v] == white && g.walk(v, visitor, colors) {
+			break
+		}
+	}
+	return nil
+}
+
+// walk returns true once the visitor stops the walk
+func (g DiGraph) walk(v Vertex, visitor Visitor, colors map[Vertex]int) bool {
+	colors[v] = grey
+	var action = visitor.DiscoverVertex(v)
+	if action == Stop {
+		return true
+	}
+	for node := g[v].head; node != nil && action != SkipChildren; node = node.next {
+		var next WalkAction
+		switch colors[node.val] {
+		case white:
+			next = visitor.TreeEdge(v, node.val)
+			if next == Continue && g.walk(node.val, visitor, colors) {
+				return true
+			}
+		case grey:
+			next = visitor.BackEdge(v, node.val)
+		default:
+			next = visitor.ForwardOrCrossEdge(v, node.val)
+		}
+		if next == Stop {
+			return true
+		}
+	}
+	colors[v] = black
+	return visitor.FinishVertex(v) == Stop
+}
+
+// UVisitor receives events of DFS run by UWGraph.Walk
+type UVisitor interface {
+	DiscoverVertex(v UVertex) WalkAction
+	FinishVertex(v UVertex) WalkAction
+	// TreeEdge leads to an undiscovered vertex
+	TreeEdge(e Edge) WalkAction
+	// BackEdge leads to a vertex being visited, it closes a cycle.
+	// Every such edge is reported once, from the later discovered vertex
+	BackEdge(e Edge) WalkAction
+}
+
+// NopUVisitor implements UVisitor doing nothing,
+// embed it to implement only the callbacks needed
+type NopUVisitor struct{}
+
+func (NopUVisitor) DiscoverVertex(v UVertex) WalkAction {
+	return Continue
+}
+
+func (NopUVisitor) FinishVertex(v UVertex) WalkAction {
+	return Continue
+}
+
+func (NopUVisitor) TreeEdge(e Edge) WalkAction {
+	return Continue
+}
+
+func (NopUVisitor) BackEdge(e Edge) WalkAction {
+	return Continue
+}
+
+// order returns vertices sorted by Id
+func (g *UWGraph) order() []UVertex {
+	var vs = make([]UVertex, 0, len(g.graph))
+	for _, v := range g.graph {
+		vs = append(vs, v)
+	}
+	sort.Slice(vs, func(i, j int) bool {
+		return vs[i].Id() < vs[j].Id()
+	})
+	return vs
+}
+
+// Walk runs DFS from every root in turn reporting events to visitor,
+// vertices discovered from one root are not visited again from the next
+// ones. All vertices are used as roots in the order of Id if none given
+func (g *UWGraph) Walk(visitor UVisitor, roots ...UVertex) error {
+	var vs = make([]UVertex, len(roots))
+	for i, v := range roots {
+		if !g.Has(v) {
+			return ErrMissingVertex
+		}
+		vs[i] = g.graph[v.Id()]
+	}
+	if len(vs) == 0 {
+		vs = g.order()
+	}
+	var colors = make(map[string]int, len(g.graph))
+	for _, v := range vs {
+		if colors[v.Id()] == white && g.walk(v, nil, visitor, colors) {
+			break
+		}
+	}
+	return nil
+}
+
+// walk returns true once the visitor stops the walk,
+// parent is the edge v has been reached by
+func (g *UWGraph) walk(v UVertex, parent Edge, visitor UVisitor, colors map[string]int) bool {
+	colors[v.Id()] = grey
+	var action = visitor.DiscoverVertex(v)
+	if action == Stop {
+		return true
+	}
+	var skipParent = parent != nil
+	for e := v.Edges().Front(); e != nil && action != SkipChildren; e = e.Next() {
+		var edge = e.Value.(Edge)
+		var to = edge.To()
+		var next WalkAction
+		switch colors[to.Id()] {
+		case white:
+			next = visitor.TreeEdge(edge)
+			if next == Continue && g.walk(to, edge, visitor, colors) {
+				return true
+			}
+		case grey:
+			if skipParent && to.Equal(parent.From()) {
+				skipParent = false
+				continue
+			}
+			next = visitor.BackEdge(edge)
+		}
+		if next == Stop {
+			return true
+		}
+	}
+	colors[v.Id()] = black
+	return visitor.FinishVertex(v) == Stop
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+type recorder struct {
+	events []string
+	skip   string
+	stop   string
+}
+
+func (r *recorder) record(event string) WalkAction {
+	r.events = append(r.events, event)
+	if event == r.stop {
+		return Stop
+	}
+	if event == r.skip {
+		return SkipChildren
+	}
+	return Continue
+}
+
+func (r *recorder) DiscoverVertex(v Vertex) WalkAction {
+	return r.record("d" + v.Id())
+}
+
+func (r *recorder) FinishVertex(v Vertex) WalkAction {
+	return r.record("f" + v.Id())
+}
+
+func (r *recorder) TreeEdge(from, to Vertex) WalkAction {
+	return r.record("t" + from.Id() + to.Id())
+}
+
+func (r *recorder) BackEdge(from, to Vertex) WalkAction {
+	return r.record("b" + from.Id() + to.Id())
+}
+
+func (r *recorder) ForwardOrCrossEdge(from, to Vertex) WalkAction {
+	return r.record("c" + from.Id() + to.Id())
+}
+
+func TestDiGraph_Walk(t *testing.T) {
+	var g, vs = mockDiGraph(
+		[]string{"A", "B", "C", "D"},
+		[][2]string{
+			{"A", "B"}, {"B", "C"}, {"C", "A"},
+			{"A", "C"}, {"D", "C"},
+		},
+	)
+	var tests = []struct {
+		name  string
+		skip  string
+		stop  string
+		roots []Vertex
+		want  string
+	}{
+		{
+			name: "all",
+			want: "dA tAB dB tBC dC bCA fC fB cAC fA dD cDC fD",
+		},
+		{
+			name: "skip tree edge",
+			skip: "tAB",
+			want: "dA tAB tAC dC bCA fC fA dB cBC fB dD cDC fD",
+		},
+		{
+			name: "skip children",
+			skip: "dB",
+			want: "dA tAB dB fB tAC dC bCA fC fA dD cDC fD",
+		},
+		{
+			name: "stop",
+			stop: "bCA",
+			want: "dA tAB dB tBC dC bCA",
+		},
+		{
+			name:  "roots",
+			roots: []Vertex{vs["D"]},
+			want:  "dD tDC dC tCA dA tAB dB bBC fB bAC fA fC fD",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r = &recorder{skip: tt.skip, stop: tt.stop}
+			if err := g.Walk(r, tt.roots...); err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Join(r.events, " "); got != tt.want {
+				t.Errorf("Walk() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if err := g.Walk(NopVisitor{}, &vertex{"Q"}); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+type uRecorder struct {
+	NopUVisitor
+	events []string
+}
+
+func (r *uRecorder) DiscoverVertex(v UVertex) WalkAction {
+	r.events = append(r.events, "d"+v.Id())
+	return Continue
+}
+
+func (r *uRecorder) TreeEdge(e Edge) WalkAction {
+	r.events = append(r.events, "t"+e.From().Id()+e.To().Id())
+	return Continue
+}
+
+func (r *uRecorder) BackEdge(e Edge) WalkAction {
+	r.events = append(r.events, "b"+e.From().Id()+e.To().Id())
+	return Continue
+}
+
+func TestUWGraph_Walk(t *testing.T) {
+	var g = NewUWGraph()
+	for _, id := range []string{"A", "B", "C", "D"} {
+		g.Add(newUV(id))
+	}
+	g.Connect(newUV("A"), newUV("B"), 1)
+	g.Connect(newUV("B"), newUV("C"), 1)
+	g.Connect(newUV("C"), newUV("A"), 1)
+	var r = &uRecorder{}
+	if err := g.Walk(r); err != nil {
+		t.Fatal(err)
+	}
+	var want = "dA tAB dB tBC dC bCA dD"
+	if got := strings.Join(r.events, " "); got != want {
+		t.Errorf("Walk() = %s, want %s", got, want)
+	}
+	if t.Failed() {
+		t.Log(g.repr())
+	}
+}
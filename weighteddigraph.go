@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// DWGraph is a directed weighted graph, Edges of a vertex
+// hold the edges leading out of it
+type DWGraph struct {
+	graph map[string]UVertex
+}
+
+func NewDWGraph() *DWGraph {
+	return &DWGraph{
+		graph: make(map[string]UVertex),
+	}
+}
+
+func (g *DWGraph) Size() int {
+	return len(g.graph)
+}
+
+func (g *DWGraph) Has(v UVertex) bool {
+	var _, ok = g.graph[v.Id()]
+	return ok
+}
+
+func (g *DWGraph) HasBoth(a, b UVertex) bool {
+	return g.Has(a) && g.Has(b)
+}
+
+func (g *DWGraph) Add(v UVertex) {
+	if !g.Has(v) {
+		g.graph[v.Id()] = v
+	}
+}
+
+func (g *DWGraph) Connect(from, to UVertex, weight float64) error {
+	if !g.HasBoth(from, to) {
+		return ErrMissingVertex
+	}
+	from = g.graph[from.Id()]
+	to = g.graph[to.Id()]
+	from.Edges().PushBack(newEdge(from, to, weight))
+	return nil
+}
+
+func (g *DWGraph) Adjacent(from, to UVertex) bool {
+	if !g.HasBoth(from, to) {
+		return false
+	}
+	for e := g.graph[from.Id()].Edges().Front(); e != nil; e = e.Next() {
+		if e.Value.(Edge).To().Equal(to) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *DWGraph) Disconnect(from, to UVertex) {
+	if !g.HasBoth(from, to) {
+		return
+	}
+	var edges = g.graph[from.Id()].Edges()
+	for e := edges.Front(); e != nil; e = e.Next() {
+		if e.Value.(Edge).To().Equal(to) {
+			edges.Remove(e)
+			break
+		}
+	}
+}
+
+// Path returns the lightest path between vertices using Dijkstra's algorithm,
+// weights must not be negative, it fails with ErrNegativeWeight
+// otherwise, use BellmanFord for such graphs
+func (g *DWGraph) Path(from, to UVertex) (*Path, error) {
+	if !g.HasBoth(from, to) {
+		return nil, ErrMissingVertex
+	}
+	var table, err = (&search{graph: g.graph}).run(g.graph[from.Id()], g.graph[to.Id()])
+	if err != nil {
+		return nil, err
+	}
+	return newPath(table, g.graph[to.Id()]), nil
+}
+
+func (g *DWGraph) repr() string {
+	var buff = &bytes.Buffer{}
+	for _, vertex := range g.graph {
+		buff.WriteString(vertex.Id())
+		buff.WriteString(" -> [ ")
+		for e := vertex.Edges().Front(); e != nil; e = e.Next() {
+			var e = e.Value.(Edge)
+			buff.WriteString("<")
+			buff.WriteString(e.To().Id())
+			buff.WriteString(":")
+			buff.WriteString(strconv.FormatFloat(e.Weight(), 'f', 2, 64))
+			buff.WriteString("> ")
+		}
+		buff.WriteString("]\n")
+	}
+	return buff.String()
+}
@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"container/list"
+	"strings"
+)
+
+// route is a path under construction, dist holds
+// the distance of every vertex from the first one
+type route struct {
+	vertices []UVertex
+	dist     []float64
+}
+
+func newRoute(table map[string]*row, to UVertex) *route {
+	var r = &route{}
+	for v := to; ; {
+		var rec = table[v.Id()]
+		r.vertices = append(r.vertices, v)
+		r.dist = append(r.dist, rec.weight)
+		if rec.previous.Equal(v) {
+			break
+		}
+		v = rec.previous
+	}
+	for i, j := 0, len(r.vertices)-1; i < j; i, j = i+1, j-1 {
+		r.vertices[i], r.vertices[j] = r.vertices[j], r.vertices[i]
+		r.dist[i], r.dist[j] = r.dist[j], r.dist[i]
+	}
+	return r
+}
+
+func (r *route) weight() float64 {
+	return r.dist[len(r.dist)-1]
+}
+
+func (r *route) key() string {
+	var ids = make([]string, len(r.vertices))
+	for i, v := range r.vertices {
+		ids[i] = v.Id()
+	}
+	return strings.Join(ids, "\x00")
+}
+
+// startsWith reports whether r begins with the first n vertices of other
+func (r *route) startsWith(other *route, n int) bool {
+	if len(r.vertices) < n {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		if !r.vertices[i].Equal(other.vertices[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *route) path() *Path {
+	var path = &Path{
+		weight:   r.weight(),
+		vertices: list.New(),
+	}
+	for _, v := range r.vertices {
+		path.vertices.PushBack(v)
+	}
+	return path
+}
+
+// KShortestPaths returns up to k lightest paths without repeated vertices
+// between vertices ordered by weight using Yen's algorithm,
+// weights must not be negative, it fails with ErrNegativeWeight otherwise
+func (g *UWGraph) KShortestPaths(from, to UVertex, k int) ([]*Path, error) {
+	if !g.HasBoth(from, to) {
+		return nil, ErrMissingVertex
+	}
+	return yen(g.graph, g.graph[from.Id()], g.graph[to.Id()], k)
+}
+
+// KShortestPaths returns up to k lightest paths without repeated vertices
+// between vertices ordered by weight using Yen's algorithm,
+// weights must not be negative, it fails with ErrNegativeWeight otherwise
+func (g *DWGraph) KShortestPaths(from, to UVertex, k int) ([]*Path, error) {
+	if !g.HasBoth(from, to) {
+		return nil, ErrMissingVertex
+	}
+	return yen(g.graph, g.graph[from.Id()], g.graph[to.Id()], k)
+}
+
+func yen(graph map[string]UVertex, from, to UVertex, k int) ([]*Path, error) {
+	var res = make([]*Path, 0, k)
+	if k <= 0 {
+		return res, nil
+	}
+	var table, err = (&search{graph: graph}).run(from, to)
+	if err != nil {
+		return nil, err
+	}
+	var found = []*route{newRoute(table, to)}
+	var candidates []*route
+	var seen = newSet()
+	seen.add(found[0].key())
+	for len(found) < k {
+		var last = found[len(found)-1]
+		for i := 0; i < len(last.vertices)-1; i++ {
+			var spur = last.vertices[i]
+			var removed = newSet()
+			for _, v := range last.vertices[:i] {
+				removed.add(v.Id())
+			}
+			var blocked = newSet()
+			for _, r := range found {
+				if r.startsWith(last, i+1) && len(r.vertices) > i+1 {
+					blocked.add(r.vertices[i+1].Id())
+				}
+			}
+			var s = &search{
+				graph: graph,
+				skip: func(e Edge) bool {
+					return removed.contains(e.To().Id()) ||
+						e.From().Equal(spur) && blocked.contains(e.To().Id())
+				},
+			}
+			var table, err = s.run(spur, to)
+			if err == ErrNoPath {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			var tail = newRoute(table, to)
+			var candidate = &route{
+				vertices: append(append([]UVertex(nil), last.vertices[:i]...), tail.vertices...),
+				dist:     append([]float64(nil), last.dist[:i]...),
+			}
+			for _, d := range tail.dist {
+				candidate.dist = append(candidate.dist, last.dist[i]+d)
+			}
+			if key := candidate.key(); !seen.contains(key) {
+				seen.add(key)
+				candidates = append(candidates, candidate)
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		var best = 0
+		for i, c := range candidates {
+			if c.weight() < candidates[best].weight() ||
+				c.weight() == candidates[best].weight() && len(c.vertices) < len(candidates[best].vertices) {
+				best = i
+			}
+		}
+		found = append(found, candidates[best])
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+	for _, r := range found {
+		res = append(res, r.path())
+	}
+	return res, nil
+}
@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestDWGraph_KShortestPaths(t *testing.T) {
+	var g = mockDWGraph([]string{"C", "D", "E", "F", "G", "H"}, []struct {
+		from, to string
+		w        float64
+	}{
+		{"C", "D", 3}, {"C", "E", 2}, {"D", "F", 4},
+		{"E", "D", 1}, {"E", "F", 2}, {"E", "G", 3},
+		{"F", "G", 2}, {"F", "H", 1}, {"G", "H", 2},
+	})
+	var paths, err = g.KShortestPaths(newUV("C"), newUV("H"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want = []struct {
+		ids    string
+		weight float64
+	}{
+		{ids: "CEFH", weight: 5},
+		{ids: "CEGH", weight: 7},
+		{ids: "CDFH", weight: 8},
+	}
+	if len(paths) != len(want) {
+		t.Fatalf("Expected %d paths, got: %d", len(want), len(paths))
+	}
+	for i, p := range paths {
+		if ids := pathIds(p); ids != want[i].ids || p.Weight() != want[i].weight {
+			t.Errorf("Path %d = %s:%v, want %s:%v", i, ids, p.Weight(), want[i].ids, want[i].weight)
+		}
+	}
+	if paths, _ = g.KShortestPaths(newUV("C"), newUV("H"), 100); len(paths) != 7 {
+		t.Errorf("Expected all 7 paths, got: %d", len(paths))
+	}
+	if _, err = g.KShortestPaths(newUV("H"), newUV("C"), 3); err != ErrNoPath {
+		t.Errorf("Expected ErrNoPath, got: %v", err)
+	}
+	if _, err = g.KShortestPaths(newUV("H"), newUV("Q"), 3); err != ErrMissingVertex {
+		t.Errorf("Expected ErrMissingVertex, got: %v", err)
+	}
+}
+
+func TestUWGraph_KShortestPaths(t *testing.T) {
+	var rnd = rand.New(rand.NewSource(1))
+	var g = NewUWGraph()
+	var vs = make([]UVertex, 8)
+	for i := range vs {
+		vs[i] = newUV(strconv.Itoa(i))
+		g.Add(vs[i])
+	}
+	for i := 0; i < 16; i++ {
+		var a, b = rnd.Intn(len(vs)), rnd.Intn(len(vs))
+		if a != b && !g.Adjacent(vs[a], vs[b]) {
+			g.Connect(vs[a], vs[b], float64(1+rnd.Intn(9)))
+		}
+	}
+	var all []float64
+	g.AllSimplePaths(vs[0], vs[7], Limits{}, func(p *Path) bool {
+		all = append(all, p.Weight())
+		return true
+	})
+	sort.Float64s(all)
+	if len(all) < 3 {
+		t.Fatalf("Expected a graph with several paths, got: %d", len(all))
+	}
+	var k = 10
+	if len(all) < k {
+		k = len(all)
+	}
+	var paths, err = g.KShortestPaths(vs[0], vs[7], k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != k {
+		t.Fatalf("Expected %d paths, got: %d", k, len(paths))
+	}
+	var seen = make(map[string]bool)
+	for i, p := range paths {
+		if math.Abs(p.Weight()-all[i]) > 1e-9 {
+			t.Errorf("Path %d weighs %v, want %v", i, p.Weight(), all[i])
+		}
+		if seen[pathIds(p)] {
+			t.Errorf("Duplicate path: %s", pathIds(p))
+		}
+		seen[pathIds(p)] = true
+	}
+	if t.Failed() {
+		t.Log(g.repr())
+	}
+}